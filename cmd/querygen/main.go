@@ -0,0 +1,60 @@
+// Command querygen generates typed table descriptors for query package from SQL DDL schema file.
+//
+// Usage with go generate:
+//
+//	//go:generate go run github.com/amarin/query/cmd/querygen -schema schema.sql -package models -out tables_gen.go
+//
+// For every CREATE TABLE statement it generates table descriptor having column accessors returning
+// query.FieldDefinition, column name constants and row struct having db tags,
+// so schema renames are caught by compiler.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/amarin/query/querygen"
+)
+
+func main() {
+	schema := flag.String("schema", "", "SQL DDL file having CREATE TABLE statements")
+	packageName := flag.String("package", os.Getenv("GOPACKAGE"), "generated file package name, defaults to $GOPACKAGE")
+	out := flag.String("out", "", "generated file path, standard output if not set")
+	flag.Parse()
+
+	if err := run(*schema, *packageName, *out); err != nil {
+		fmt.Fprintln(os.Stderr, "querygen:", err)
+		os.Exit(1)
+	}
+}
+
+// run generates descriptors of schema tables and writes them into output file or standard output.
+func run(schema string, packageName string, out string) error {
+	if len(schema) == 0 || len(packageName) == 0 {
+		return fmt.Errorf("%w: both -schema and -package required", querygen.Error)
+	}
+
+	ddl, err := os.ReadFile(schema)
+	if err != nil {
+		return err
+	}
+
+	tables, err := querygen.Parse(string(ddl))
+	if err != nil {
+		return err
+	}
+
+	code, err := querygen.Generate(tables, packageName, filepath.Base(schema))
+	if err != nil {
+		return err
+	}
+
+	if len(out) == 0 {
+		_, err = os.Stdout.Write(code)
+		return err
+	}
+
+	return os.WriteFile(out, code, 0o644)
+}
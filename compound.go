@@ -0,0 +1,277 @@
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SetOperation defines SQL set operation to combine select queries results.
+type SetOperation int
+
+const (
+	// UnionOperation combines queries results removing duplicated rows.
+	UnionOperation SetOperation = iota
+
+	// UnionAllOperation combines queries results keeping duplicated rows.
+	UnionAllOperation
+
+	// IntersectOperation returns rows present in both queries results.
+	IntersectOperation
+
+	// ExceptOperation returns rows of the first query result missing in the second one.
+	ExceptOperation
+)
+
+// String returns SQL representation of SetOperation value.
+// Implements fmt.Stringer.
+func (operation SetOperation) String() string {
+	switch operation {
+	case UnionOperation:
+		return "UNION"
+	case UnionAllOperation:
+		return "UNION ALL"
+	case IntersectOperation:
+		return "INTERSECT"
+	case ExceptOperation:
+		return "EXCEPT"
+	default:
+		return "unknown set operation(" + strconv.Itoa(int(operation)) + ")"
+	}
+}
+
+// renderFor renders SetOperation keyword for specified Dialect.
+func (operation SetOperation) renderFor(dialect Dialect) string {
+	if operation == ExceptOperation && dialect.kind == kindOracle {
+		return "MINUS"
+	}
+
+	return operation.String()
+}
+
+// compoundMember defines single query combined into CompoundSelectBuilder with set operation.
+type compoundMember struct {
+	operation SetOperation // operation to combine with previous members, ignored for the first member
+	query     SubQuery
+}
+
+// CompoundSelectBuilder helps to build SQL queries combining several select queries results
+// with UNION, UNION ALL, INTERSECT or EXCEPT set operations.
+// Combined result could be ordered and paginated with OrderBy, Offset and Limit.
+// Use Union, UnionAll, Intersect or Except to make CompoundSelectBuilder.
+type CompoundSelectBuilder struct {
+	members []compoundMember
+	order   []FieldSorting
+	offset  uint
+	limit   int
+}
+
+// combine returns a copy of CompoundSelectBuilder having queries added with specified set operation.
+func (compound CompoundSelectBuilder) combine(operation SetOperation, queries ...SubQuery) CompoundSelectBuilder {
+	members := make([]compoundMember, 0, len(compound.members)+len(queries))
+	members = append(members, compound.members...)
+
+	for _, query := range queries {
+		members = append(members, compoundMember{operation: operation, query: query})
+	}
+
+	compound.members = members
+
+	return compound
+}
+
+// Union returns a copy of CompoundSelectBuilder having specified queries results combined using UNION.
+func (compound CompoundSelectBuilder) Union(queries ...SubQuery) CompoundSelectBuilder {
+	return compound.combine(UnionOperation, queries...)
+}
+
+// UnionAll returns a copy of CompoundSelectBuilder having specified queries results combined using UNION ALL.
+func (compound CompoundSelectBuilder) UnionAll(queries ...SubQuery) CompoundSelectBuilder {
+	return compound.combine(UnionAllOperation, queries...)
+}
+
+// Intersect returns a copy of CompoundSelectBuilder having specified queries results combined using INTERSECT.
+func (compound CompoundSelectBuilder) Intersect(queries ...SubQuery) CompoundSelectBuilder {
+	return compound.combine(IntersectOperation, queries...)
+}
+
+// Except returns a copy of CompoundSelectBuilder having specified queries results combined using EXCEPT.
+// Rendered as MINUS for Oracle.
+func (compound CompoundSelectBuilder) Except(queries ...SubQuery) CompoundSelectBuilder {
+	return compound.combine(ExceptOperation, queries...)
+}
+
+// OrderBy adds combined result ordering fields and returns modified CompoundSelectBuilder.
+// Note fields are referred by the first query field names or aliases.
+func (compound CompoundSelectBuilder) OrderBy(orderByFields ...FieldSorting) CompoundSelectBuilder {
+	compound.order = append(append(make([]FieldSorting, 0, len(compound.order)), compound.order...), orderByFields...)
+	return compound
+}
+
+// Offset sets combined result offset and returns modified CompoundSelectBuilder.
+func (compound CompoundSelectBuilder) Offset(offset uint) CompoundSelectBuilder {
+	compound.offset = offset
+	return compound
+}
+
+// Limit sets combined result limit and returns modified CompoundSelectBuilder. Values -1 to disable limit in query.
+func (compound CompoundSelectBuilder) Limit(limit int) CompoundSelectBuilder {
+	compound.limit = limit
+	return compound
+}
+
+// RenderDialect renders combined SQL SELECT queries using specified Dialect placeholders.
+// Takes existed parameters count (0 means no parameters are defined yet),
+// members parameters are numbered in order of appearance followed by offset and limit.
+// Implements DialectClauseRenderer.
+func (compound CompoundSelectBuilder) RenderDialect(dialect Dialect, parametersCount int) (sql string) {
+	sql, _ = compound.render(dialect, parametersCount)
+	return sql
+}
+
+// render renders combined SQL SELECT queries and collects parameters in substitutions order.
+func (compound CompoundSelectBuilder) render(dialect Dialect, parametersCount int) (sql string, params []any) {
+	tokens := make([]string, 0, 2*len(compound.members)+2)
+	params = make([]any, 0)
+
+	for idx, member := range compound.members {
+		if idx > 0 {
+			tokens = append(tokens, member.operation.renderFor(dialect))
+		}
+
+		memberSQL := member.query.RenderDialect(dialect, parametersCount+len(params))
+		if isOrderedOrLimited(member.query) {
+			memberSQL = "(" + memberSQL + ")"
+		}
+
+		tokens = append(tokens, memberSQL)
+		params = append(params, member.query.ValuesFor(dialect)...)
+	}
+
+	if len(compound.order) > 0 {
+		orderFields := make([]string, len(compound.order))
+		for idx, order := range compound.order {
+			orderFields[idx] = order.RenderFor(dialect)
+		}
+
+		tokens = append(tokens, "ORDER BY", strings.Join(orderFields, ", "))
+	}
+
+	var pagination string
+
+	pagination, params = renderPagination(
+		dialect, parametersCount, params, len(compound.order) > 0, compound.offset, compound.limit)
+	if len(pagination) > 0 {
+		tokens = append(tokens, pagination)
+	}
+
+	return strings.Join(tokens, " "), params
+}
+
+// ValuesFor returns a set of parameters to substitute into query rendered with specified Dialect.
+// Implements SubQuery.
+func (compound CompoundSelectBuilder) ValuesFor(dialect Dialect) (params []any) {
+	_, params = compound.render(dialect, 0)
+	return params
+}
+
+// Values returns a set of parameters to substitute into rendered query.
+// Implements ValuesProvider.
+func (compound CompoundSelectBuilder) Values() (params []any) {
+	return compound.ValuesFor(PostgreSQL)
+}
+
+// FieldDefinitions returns a list of fields the first query selects.
+// Implements SubQuery.
+func (compound CompoundSelectBuilder) FieldDefinitions() []FieldDefinition {
+	if len(compound.members) == 0 {
+		return make([]FieldDefinition, 0)
+	}
+
+	return compound.members[0].query.FieldDefinitions()
+}
+
+// String returns debug representation of CompoundSelectBuilder query having parameters inlined, see DebugSQL.
+// Implements fmt.Stringer.
+func (compound CompoundSelectBuilder) String() string {
+	return DebugSQL(compound)
+}
+
+// BuildQueryAndParams generates sql query string with desired parameters set.
+// If query generation failed returns empty query and parameters set or non-nil error.
+// Uses PostgreSQL "$N" placeholders, use BuildQueryAndParamsFor to generate query for another Dialect.
+func (compound CompoundSelectBuilder) BuildQueryAndParams() (sql string, params []any, err error) {
+	return compound.BuildQueryAndParamsFor(PostgreSQL)
+}
+
+// BuildQueryAndParamsFor generates sql query string with desired parameters set using specified Dialect.
+// Returns error if less than two queries combined or queries select different fields count.
+// SQLite does not allow ordered or limited members so error returned in such case too.
+func (compound CompoundSelectBuilder) BuildQueryAndParamsFor(dialect Dialect) (sql string, params []any, err error) {
+	if len(compound.members) < 2 {
+		return "", make([]any, 0), fmt.Errorf("%w: at least two queries required to combine", Error)
+	}
+
+	fieldsCount := len(compound.members[0].query.FieldDefinitions())
+
+	for idx, member := range compound.members {
+		if count := len(member.query.FieldDefinitions()); count != fieldsCount {
+			return "", make([]any, 0), fmt.Errorf("%w: query %d selects %d fields while the first one selects %d",
+				Error, idx, count, fieldsCount)
+		}
+
+		if dialect.kind == kindSQLite && isOrderedOrLimited(member.query) {
+			return "", make([]any, 0), fmt.Errorf("%w: %v dialect does not support ordered or limited query %d",
+				Error, dialect, idx)
+		}
+	}
+
+	sql, params = compound.render(dialect, 0)
+
+	return sql, params, nil
+}
+
+// isOrderedOrLimited returns true if query has its own ordering, pagination or set operations
+// so it should be enclosed into brackets when combined.
+func isOrderedOrLimited(query SubQuery) bool {
+	switch typed := query.(type) {
+	case SelectManyBuilder:
+		return len(typed.order) > 0 || typed.offset > 0 || typed.limit > 0
+	case SelectSingleBuilder, CompoundSelectBuilder, WithBuilder:
+		return true
+	default:
+		return false
+	}
+}
+
+// newCompound makes CompoundSelectBuilder combining queries with specified set operation.
+func newCompound(operation SetOperation, first SubQuery, second SubQuery, more ...SubQuery) CompoundSelectBuilder {
+	return CompoundSelectBuilder{limit: noLimit}.
+		combine(operation, first).
+		combine(operation, append([]SubQuery{second}, more...)...)
+}
+
+// Union makes CompoundSelectBuilder combining queries results with UNION removing duplicated rows.
+// Takes two or more queries such as BaseSelectBuilder or SelectManyBuilder selecting the same fields count.
+func Union(first SubQuery, second SubQuery, more ...SubQuery) CompoundSelectBuilder {
+	return newCompound(UnionOperation, first, second, more...)
+}
+
+// UnionAll makes CompoundSelectBuilder combining queries results with UNION ALL keeping duplicated rows.
+// Takes two or more queries such as BaseSelectBuilder or SelectManyBuilder selecting the same fields count.
+func UnionAll(first SubQuery, second SubQuery, more ...SubQuery) CompoundSelectBuilder {
+	return newCompound(UnionAllOperation, first, second, more...)
+}
+
+// Intersect makes CompoundSelectBuilder returning rows present in all queries results.
+// Takes two or more queries such as BaseSelectBuilder or SelectManyBuilder selecting the same fields count.
+func Intersect(first SubQuery, second SubQuery, more ...SubQuery) CompoundSelectBuilder {
+	return newCompound(IntersectOperation, first, second, more...)
+}
+
+// Except makes CompoundSelectBuilder returning rows of the first query result missing in the following ones.
+// Takes two or more queries such as BaseSelectBuilder or SelectManyBuilder selecting the same fields count.
+// Rendered as MINUS for Oracle.
+func Except(first SubQuery, second SubQuery, more ...SubQuery) CompoundSelectBuilder {
+	return newCompound(ExceptOperation, first, second, more...)
+}
@@ -0,0 +1,110 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/query"
+)
+
+func TestCompoundSelectBuilder_BuildQueryAndParamsFor(t *testing.T) {
+	active := query.SelectFrom("users").Fields(query.Field("id"), query.Field("email")).
+		Where(query.EqualTo("active", true))
+	invited := query.SelectFrom("invites").Fields(query.Field("user_id"), query.Field("email")).
+		Where(query.GreaterThan("sent_at", "2024-01-01"))
+	banned := query.SelectFrom("bans").Fields(query.Field("user_id"), query.Field("email"))
+
+	tests := []struct {
+		name       string
+		builder    query.CompoundSelectBuilder
+		dialect    query.Dialect
+		wantSQL    string
+		wantParams []any
+		wantErr    bool
+	}{
+		{
+			"union",
+			query.Union(active, invited),
+			query.PostgreSQL,
+			"SELECT id, email FROM users WHERE active=$1 UNION " +
+				"SELECT user_id, email FROM invites WHERE sent_at>$2",
+			[]any{true, "2024-01-01"},
+			false,
+		},
+		{
+			"union_all_except_ordered_limited",
+			query.UnionAll(active, invited).Except(banned).OrderBy(query.ASC("email")).Offset(20).Limit(10),
+			query.PostgreSQL,
+			"SELECT id, email FROM users WHERE active=$1 UNION ALL " +
+				"SELECT user_id, email FROM invites WHERE sent_at>$2 EXCEPT " +
+				"SELECT user_id, email FROM bans ORDER BY email ASC OFFSET $3 LIMIT $4",
+			[]any{true, "2024-01-01", uint(20), uint(10)},
+			false,
+		},
+		{
+			"intersect_mysql",
+			query.Intersect(active, invited).Offset(20).Limit(10),
+			query.MySQL,
+			"SELECT id, email FROM users WHERE active=? INTERSECT " +
+				"SELECT user_id, email FROM invites WHERE sent_at>? LIMIT ? OFFSET ?",
+			[]any{true, "2024-01-01", uint(10), uint(20)},
+			false,
+		},
+		{
+			"except_oracle",
+			query.Except(active, banned),
+			query.Oracle,
+			"SELECT id, email FROM users WHERE active=:1 MINUS SELECT user_id, email FROM bans",
+			[]any{true},
+			false,
+		},
+		{
+			"limited_member",
+			query.Union(active.OrderBy(query.DESC("id")).Limit(5), invited),
+			query.PostgreSQL,
+			"(SELECT id, email FROM users WHERE active=$1 ORDER BY id DESC LIMIT $2) UNION " +
+				"SELECT user_id, email FROM invites WHERE sent_at>$3",
+			[]any{true, uint(5), "2024-01-01"},
+			false,
+		},
+		{
+			"limited_member_sqlite",
+			query.Union(active.Limit(5), invited),
+			query.SQLite,
+			"", nil, true,
+		},
+		{
+			"fields_count_mismatch",
+			query.Union(active, query.SelectFrom("invites").Fields(query.Field("email"))),
+			query.PostgreSQL,
+			"", nil, true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSQL, gotParams, err := tt.builder.BuildQueryAndParamsFor(tt.dialect)
+			require.Equalf(t, tt.wantErr, err != nil, "want error %v, got %v", tt.wantErr, err)
+			if err != nil {
+				require.ErrorIs(t, err, query.Error)
+				return
+			}
+			require.Equal(t, tt.wantSQL, gotSQL)
+			require.Equal(t, tt.wantParams, gotParams)
+		})
+	}
+}
+
+func TestCompoundSelectBuilder_SubQuery(t *testing.T) {
+	ids := query.Union(
+		query.SelectFrom("users").Fields(query.Field("id")).Where(query.EqualTo("active", true)),
+		query.SelectFrom("admins").Fields(query.Field("user_id")),
+	)
+	sql, params, err := query.SelectFrom("orders").
+		Where(query.EqualTo("status", "new"), query.In("user_id", ids)).
+		BuildQueryAndParams()
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM orders WHERE status=$1 AND user_id IN "+
+		"(SELECT id FROM users WHERE active=$2 UNION SELECT user_id FROM admins)", sql)
+	require.Equal(t, []any{"new", true}, params)
+}
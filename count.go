@@ -1,6 +1,7 @@
 package query
 
 import (
+	"fmt"
 	"strings"
 )
 
@@ -9,6 +10,14 @@ import (
 type CountBuilder struct {
 	baseBuilder BaseSelectBuilder
 	countField  FieldDefinition
+	distinct    bool // count distinct field values only
+}
+
+// Distinct returns a copy of CountBuilder counting distinct values of counted field only, i.e. COUNT(DISTINCT field).
+// Requires field name to count set, query build fails for default '*'.
+func (query CountBuilder) Distinct() CountBuilder {
+	query.distinct = true
+	return query
 }
 
 // RenderFrom returns string representation of table name or tables join with possible tables aliases.
@@ -17,19 +26,59 @@ func (query CountBuilder) RenderFrom() string {
 	return query.baseBuilder.RenderFrom()
 }
 
+// String returns debug representation of CountBuilder query having parameters inlined, see DebugSQL.
+// Implements fmt.Stringer.
+func (query CountBuilder) String() string {
+	return DebugSQL(query)
+}
+
 // BuildQueryAndParams generates sql query string with desired parameters set.
 // If query generation failed returns empty query and parameters set or non-nil error.
+// Uses PostgreSQL "$N" placeholders, use BuildQueryAndParamsFor to generate query for another Dialect.
 func (query CountBuilder) BuildQueryAndParams() (sql string, params []interface{}, err error) {
-	tokens := append([]string{},
-		DoSelect.String(),
-		kwCount.String()+"("+query.countField.RenderField()+")",
-		kwFrom.String(),
-		query.baseBuilder.RenderFrom(),
-	)
+	return query.BuildQueryAndParamsFor(PostgreSQL)
+}
+
+// BuildQueryAndParamsFor generates sql query string with desired parameters set using specified Dialect.
+// If query generation failed returns empty query and parameters set or non-nil error.
+// Query selecting distinct rows or groups is wrapped into subquery to count its result rows,
+// i.e. SELECT COUNT(*) FROM (SELECT DISTINCT ...) counted. Field to count is not qualified by table name then.
+// Returns error if distinct values count requested without field name to count
+// or DISTINCT ON clause is not supported by Dialect.
+func (query CountBuilder) BuildQueryAndParamsFor(dialect Dialect) (sql string, params []interface{}, err error) {
+	aggregated := query.baseBuilder.isAggregated()
+	counted := query.countField.RenderFieldFor(dialect)
+
+	if aggregated {
+		// tables are not visible outside of subquery, count by selected column name
+		counted = FieldDefinition{fieldName: query.countField.fieldName}.RenderFieldFor(dialect)
+	}
+
+	if query.distinct {
+		if query.countField.fieldName == "*" {
+			return "", nil, fmt.Errorf("%w: field name required to count distinct values", Error)
+		}
+
+		counted = kwDistinct.String() + " " + counted
+	}
+
+	tokens := append([]string{}, DoSelect.String(), kwCount.String()+"("+counted+")", kwFrom.String())
+
+	if aggregated {
+		if err = query.baseBuilder.validateDistinct(dialect); err != nil {
+			return "", nil, err
+		}
+
+		tokens = append(tokens, "("+query.baseBuilder.RenderDialect(dialect, 0)+")", "counted")
+
+		return strings.Join(tokens, " "), query.baseBuilder.ValuesFor(dialect), nil
+	}
+
+	tokens = append(tokens, query.baseBuilder.RenderFromFor(dialect))
 
 	if len(query.baseBuilder.where.Conditions()) > 0 {
-		tokens = append(tokens, kwWhere.String(), query.baseBuilder.where.Render(0))
-		params = query.baseBuilder.where.Values()
+		tokens = append(tokens, kwWhere.String(), query.baseBuilder.where.RenderDialect(dialect, 0))
+		params = query.baseBuilder.where.ValuesFor(dialect)
 	}
 
 	return strings.Join(tokens, " "), params, nil
@@ -38,6 +87,7 @@ func (query CountBuilder) BuildQueryAndParams() (sql string, params []interface{
 // Count prepares SQL SELECT COUNT query builder.
 // Takes BaseSelectBuilder instance and optional mustField name to count over it.
 // If no mustField name specified default '*' will used.
+// Use CountBuilder.Distinct to count distinct field values only.
 // Returns CountBuilder instance.
 func Count(query BaseSelectBuilder, fieldToCount ...FieldName) (countBuilder CountBuilder) {
 	countBuilder = CountBuilder{baseBuilder: query}
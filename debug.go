@@ -0,0 +1,201 @@
+package query
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// debugMark prefixes every debug rendered query to make clear it is not for execution.
+const debugMark = "/* DEBUG ONLY, DO NOT EXECUTE */ "
+
+// DebugSQL renders query built by specified builder using PostgreSQL dialect
+// with parameters inlined as SQL literals. See DebugSQLFor for details.
+func DebugSQL(builder QueryBuilder) string {
+	return DebugSQLFor(builder, PostgreSQL)
+}
+
+// DebugSQLFor renders query built by specified builder using specified Dialect
+// with parameters inlined as SQL literals: strings are quoted and escaped, times formatted,
+// nil rendered as NULL and byte slices as hex literals.
+// Result is marked with comment prefix and intended for logging and debugging only,
+// never execute it, use BuildQueryAndParamsFor to get query with bound parameters.
+// If query build fails returns comment containing the error.
+func DebugSQLFor(builder QueryBuilder, dialect Dialect) string {
+	sql, params, err := builder.BuildQueryAndParamsFor(dialect)
+	if err != nil {
+		return debugMark + "/* " + strings.ReplaceAll(err.Error(), "*/", "* /") + " */"
+	}
+
+	return debugMark + inlineParams(dialect, sql, params)
+}
+
+// inlineParams replaces parameters substitutions in sql with parameters literals.
+// Quoted strings and identifiers are kept untouched. Unknown substitutions are kept as is.
+func inlineParams(dialect Dialect, sql string, params []any) string {
+	var (
+		builder  strings.Builder
+		position int // next question placeholder position
+	)
+
+	runes := []rune(sql)
+
+	for pos := 0; pos < len(runes); pos++ {
+		char := runes[pos]
+
+		switch {
+		case char == '\'' || char == '"' || char == '`' || (char == '[' && dialect.kind == kindSQLServer):
+			end := quotedEnd(runes, pos)
+			builder.WriteString(string(runes[pos:end]))
+			pos = end - 1
+		case char == '?' && dialect.placeholders == QuestionPlaceholders:
+			position++
+			builder.WriteString(paramLiteral(dialect, params, position, "?"))
+		case isNumberedPlaceholder(dialect.placeholders, runes, pos):
+			start := pos + len([]rune(placeholderPrefix(dialect.placeholders)))
+
+			end := start
+			for end < len(runes) && unicode.IsDigit(runes[end]) {
+				end++
+			}
+
+			number, _ := strconv.Atoi(string(runes[start:end]))
+			builder.WriteString(paramLiteral(dialect, params, number, string(runes[pos:end])))
+			pos = end - 1
+		default:
+			builder.WriteRune(char)
+		}
+	}
+
+	return builder.String()
+}
+
+// quotedEnd returns position following closing quote of quoted string or identifier started at specified position.
+// Doubled closing quotes are treated as escaped ones.
+func quotedEnd(runes []rune, start int) int {
+	closing := runes[start]
+	if closing == '[' {
+		closing = ']'
+	}
+
+	for pos := start + 1; pos < len(runes); pos++ {
+		if runes[pos] != closing {
+			continue
+		}
+
+		if pos+1 < len(runes) && runes[pos+1] == closing {
+			pos++
+			continue
+		}
+
+		return pos + 1
+	}
+
+	return len(runes)
+}
+
+// isNumberedPlaceholder returns true if numbered placeholder of specified style starts at specified position.
+func isNumberedPlaceholder(style PlaceholderStyle, runes []rune, pos int) bool {
+	if style == QuestionPlaceholders {
+		return false
+	}
+
+	prefix := []rune(placeholderPrefix(style))
+	end := pos + len(prefix)
+
+	return end < len(runes) && string(runes[pos:end]) == string(prefix) && unicode.IsDigit(runes[end]) &&
+		(pos == 0 || !unicode.IsLetter(runes[pos-1]) && !unicode.IsDigit(runes[pos-1]))
+}
+
+// placeholderPrefix returns numbered placeholder prefix preceding parameter position, i.e. "$" or "@p".
+func placeholderPrefix(style PlaceholderStyle) string {
+	return strings.TrimSuffix(style.Placeholder(0), "0")
+}
+
+// paramLiteral renders parameter at specified position as literal or returns placeholder if position is unknown.
+func paramLiteral(dialect Dialect, params []any, position int, placeholder string) string {
+	if position < 1 || position > len(params) {
+		return placeholder
+	}
+
+	return debugLiteral(dialect, params[position-1])
+}
+
+// debugLiteral renders value as SQL literal of specified Dialect.
+func debugLiteral(dialect Dialect, value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return "NULL"
+	case driver.Valuer:
+		if reflected := reflect.ValueOf(typed); reflected.Kind() == reflect.Pointer && reflected.IsNil() {
+			return "NULL"
+		}
+
+		if converted, err := typed.Value(); err == nil {
+			return debugLiteral(dialect, converted)
+		}
+	case ValueProvider:
+		return debugLiteral(dialect, typed.DatabaseValue())
+	case string:
+		return quoteLiteral(dialect, typed)
+	case []byte:
+		return hexLiteral(dialect, typed)
+	case time.Time:
+		if dialect.kind == kindMySQL {
+			return quoteLiteral(dialect, typed.Format("2006-01-02 15:04:05.999999"))
+		}
+
+		return quoteLiteral(dialect, typed.Format("2006-01-02 15:04:05.999999Z07:00"))
+	case bool:
+		switch {
+		case dialect.kind == kindPostgreSQL || dialect.kind == kindMySQL:
+			return strings.ToUpper(strconv.FormatBool(typed))
+		case typed:
+			return "1"
+		default:
+			return "0"
+		}
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return fmt.Sprintf("%v", typed)
+	}
+
+	if reflected := reflect.ValueOf(value); reflected.Kind() == reflect.Pointer {
+		if reflected.IsNil() {
+			return "NULL"
+		}
+
+		return debugLiteral(dialect, reflected.Elem().Interface())
+	}
+
+	return quoteLiteral(dialect, fmt.Sprintf("%v", value))
+}
+
+// quoteLiteral renders string literal escaping quotes, MySQL backslashes are escaped too.
+func quoteLiteral(dialect Dialect, value string) string {
+	if dialect.kind == kindMySQL {
+		value = strings.ReplaceAll(value, `\`, `\\`)
+	}
+
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// hexLiteral renders binary literal in specified Dialect notation.
+func hexLiteral(dialect Dialect, value []byte) string {
+	encoded := hex.EncodeToString(value)
+
+	switch dialect.kind {
+	case kindPostgreSQL:
+		return `'\x` + encoded + `'::bytea`
+	case kindSQLServer:
+		return "0x" + encoded
+	case kindOracle:
+		return "HEXTORAW('" + encoded + "')"
+	default:
+		return "X'" + encoded + "'"
+	}
+}
@@ -0,0 +1,115 @@
+package query_test
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/query"
+)
+
+func TestDebugSQLFor(t *testing.T) {
+	moment := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+	name := "o'neil"
+	base := query.SelectManyFrom("users").Where(
+		query.EqualTo("name", name),
+		query.GreaterOrEqual("created_at", moment),
+		query.EqualTo("active", true),
+		query.EqualTo("avatar", []byte{0xde, 0xad}),
+		query.EqualTo("nick", &name),
+		query.EqualTo("note", sql.NullString{}),
+	).Limit(10)
+
+	tests := []struct {
+		name    string
+		dialect query.Dialect
+		want    string
+	}{
+		{
+			"postgres",
+			query.PostgreSQL,
+			"SELECT * FROM users WHERE name='o''neil' AND created_at>='2024-03-01 12:30:00Z' AND active=TRUE " +
+				`AND avatar='\xdead'::bytea AND nick='o''neil' AND note=NULL LIMIT 10`,
+		},
+		{
+			"mysql",
+			query.MySQL,
+			"SELECT * FROM users WHERE name='o''neil' AND created_at>='2024-03-01 12:30:00' AND active=TRUE " +
+				"AND avatar=X'dead' AND nick='o''neil' AND note=NULL LIMIT 10",
+		},
+		{
+			"sql_server",
+			query.SQLServer,
+			"SELECT * FROM users WHERE name='o''neil' AND created_at>='2024-03-01 12:30:00Z' AND active=1 " +
+				"AND avatar=0xdead AND nick='o''neil' AND note=NULL ORDER BY (SELECT NULL) OFFSET 0 ROWS FETCH NEXT 10 ROWS ONLY",
+		},
+		{
+			"oracle",
+			query.Oracle,
+			"SELECT * FROM users WHERE name='o''neil' AND created_at>='2024-03-01 12:30:00Z' AND active=1 " +
+				"AND avatar=HEXTORAW('dead') AND nick='o''neil' AND note=NULL OFFSET 0 ROWS FETCH NEXT 10 ROWS ONLY",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, "/* DEBUG ONLY, DO NOT EXECUTE */ "+tt.want, query.DebugSQLFor(base, tt.dialect))
+		})
+	}
+}
+
+func TestDebugSQL_Placeholders(t *testing.T) {
+	values := make([]string, 11)
+	for idx := range values {
+		values[idx] = fmt.Sprint(idx + 1)
+	}
+
+	builder := query.SelectFrom("t").Where(query.In("id", values), query.EqualTo("f", `a\b`))
+	require.Equal(t,
+		"/* DEBUG ONLY, DO NOT EXECUTE */ SELECT * FROM t WHERE id IN ('1','2','3','4','5','6','7','8','9','10','11') AND f='a\\b'",
+		query.DebugSQL(builder))
+	require.Contains(t, query.DebugSQLFor(builder, query.MySQL), `f='a\\b'`)
+	require.Contains(t, query.DebugSQLFor(builder, query.SQLServer.WithQuoting(query.QuoteAlways)), `[f]='a\b'`)
+}
+
+func TestBuilders_String(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder fmt.Stringer
+		want    string
+	}{
+		{"base", query.SelectFrom("users").Where(query.EqualTo("id", 1)), "SELECT * FROM users WHERE id=1"},
+		{"single", query.SelectSingleFrom("users").Where(query.EqualTo("id", 1)), "SELECT * FROM users WHERE id=1 LIMIT 1"},
+		{"count", query.SelectFrom("users").Where(query.EqualTo("id", 1)).Count(), "SELECT COUNT(*) FROM users WHERE id=1"},
+		{
+			"insert",
+			query.InsertInto("users").Values(query.FieldName("name").Value("bob")),
+			"INSERT INTO users(name) VALUES ('bob')",
+		},
+		{
+			"update",
+			query.Update("users").Set(query.FieldName("name").Value(nil)).Where(query.EqualTo("id", 2)),
+			"UPDATE users SET name=NULL WHERE id=2",
+		},
+		{"delete", query.Delete("users").Where(query.EqualTo("id", 3)), "DELETE FROM users WHERE id=3"},
+		{
+			"with",
+			query.With("u", query.SelectFrom("users").Where(query.EqualTo("id", 4))).Select(query.SelectFrom("u")),
+			"WITH u AS (SELECT * FROM users WHERE id=4) SELECT * FROM u",
+		},
+		{
+			"compound",
+			query.Union(query.SelectFrom("a").Where(query.EqualTo("id", 5)), query.SelectFrom("b")),
+			"SELECT * FROM a WHERE id=5 UNION SELECT * FROM b",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, "/* DEBUG ONLY, DO NOT EXECUTE */ "+tt.want, tt.builder.String())
+		})
+	}
+
+	require.Contains(t, query.InsertInto("users").String(), "/* query: ")
+}
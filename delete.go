@@ -10,6 +10,7 @@ type DeleteBuilder struct {
 	BaseBuilder
 	tableName TableName
 	where     Group
+	returning returningClause
 }
 
 // RenderFrom returns string representation of table name or tables join with possible tables aliases.
@@ -23,9 +24,32 @@ func (updater DeleteBuilder) Where(fieldConditions ...Condition) DeleteBuilder {
 	return updater
 }
 
+// Returning generates new DeleteBuilder requesting deleted rows fields using SQL RETURNING clause.
+// Fields are rendered with their aliases. If no fields specified, all fields are returned.
+// Note query build fails for dialects not supporting RETURNING clause.
+func (updater DeleteBuilder) Returning(fields ...FieldDefinition) DeleteBuilder {
+	updater.returning = updater.returning.Fields(fields...)
+	return updater
+}
+
+// String returns debug representation of DeleteBuilder query having parameters inlined, see DebugSQL.
+// Implements fmt.Stringer.
+func (updater DeleteBuilder) String() string {
+	return DebugSQL(updater)
+}
+
 // BuildQueryAndParams returns query string and params to fill in SQL DELETE query string.
 // If query build failed returns non-nil error.
+// Uses PostgreSQL "$N" placeholders, use BuildQueryAndParamsFor to generate query for another Dialect.
 func (updater DeleteBuilder) BuildQueryAndParams() (sql string, params []interface{}, err error) {
+	return updater.BuildQueryAndParamsFor(PostgreSQL)
+}
+
+// BuildQueryAndParamsFor returns query string and params to fill in SQL DELETE query string using specified Dialect.
+// If query build failed returns non-nil error.
+func (updater DeleteBuilder) BuildQueryAndParamsFor(dialect Dialect) (sql string, params []interface{}, err error) {
+	var returning string
+
 	// disallow some cases
 	switch {
 	case len(updater.where.conditions) == 0:
@@ -34,17 +58,25 @@ func (updater DeleteBuilder) BuildQueryAndParams() (sql string, params []interfa
 		return "", params, fmt.Errorf("%w: no table name set", Error)
 	}
 
-	sql = strings.Join([]string{
+	if returning, err = updater.returning.RenderFor(dialect); err != nil {
+		return "", params, err
+	}
+
+	tokens := []string{
 		kwDelete.String(),
 		kwFrom.String(),
-		updater.tableName.String(),
+		updater.tableName.RenderFromFor(dialect),
 		kwWhere.String(),
-		updater.where.Render(len(params)),
-	}, " ")
+		updater.where.RenderDialect(dialect, len(params)),
+	}
+
+	if len(returning) > 0 {
+		tokens = append(tokens, returning)
+	}
 
-	params = append(params, updater.where.Values()...)
+	params = append(params, updater.where.ValuesFor(dialect)...)
 
-	return sql, params, nil
+	return strings.Join(tokens, " "), params, nil
 }
 
 // TableName returns table name to delete.
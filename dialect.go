@@ -0,0 +1,226 @@
+package query
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PlaceholderStyle defines how parameters substitutions are rendered in generated SQL queries.
+type PlaceholderStyle int
+
+const (
+	// DollarPlaceholders defines numbered "$N" substitutions as expected by postgresql drivers.
+	DollarPlaceholders PlaceholderStyle = iota
+
+	// QuestionPlaceholders defines not numbered "?"(question) substitutions as expected by MySQL or SQLite drivers.
+	QuestionPlaceholders
+
+	// AtPlaceholders defines numbered "@pN" substitutions as expected by SQL Server drivers.
+	AtPlaceholders
+
+	// ColonPlaceholders defines numbered ":N" substitutions as expected by Oracle drivers.
+	ColonPlaceholders
+)
+
+// String returns string representation of PlaceholderStyle value.
+// Implements fmt.Stringer.
+func (style PlaceholderStyle) String() string {
+	switch style {
+	case DollarPlaceholders:
+		return "$N"
+	case QuestionPlaceholders:
+		return "?"
+	case AtPlaceholders:
+		return "@pN"
+	case ColonPlaceholders:
+		return ":N"
+	default:
+		return "unknown placeholders(" + strconv.Itoa(int(style)) + ")"
+	}
+}
+
+// Placeholder renders single parameter substitution for specified parameter position.
+// Parameters positions are started from 1.
+func (style PlaceholderStyle) Placeholder(position int) string {
+	switch style {
+	case QuestionPlaceholders:
+		return "?"
+	case AtPlaceholders:
+		return "@p" + strconv.Itoa(position)
+	case ColonPlaceholders:
+		return ":" + strconv.Itoa(position)
+	default:
+		return "$" + strconv.Itoa(position)
+	}
+}
+
+// dialectKind enumerates database engines known to Dialect.
+type dialectKind int
+
+const (
+	kindPostgreSQL dialectKind = iota
+	kindMySQL
+	kindSQLite
+	kindSQLServer
+	kindOracle
+)
+
+// Dialect defines database specific rules to render SQL queries.
+// It defines parameters substitution style as well as database specific syntax of generated clauses.
+// Use one of predefined PostgreSQL, MySQL, SQLite, SQLServer or Oracle values.
+// Zero value Dialect is equal to PostgreSQL.
+// Predefined dialects do not quote identifiers, use WithQuoting to enable it.
+// Dialect is immutable, modifiers such as WithPlaceholders or WithQuoting return an updated copy.
+type Dialect struct {
+	kind         dialectKind      // database engine to render syntax for
+	placeholders PlaceholderStyle // parameters substitution style
+	quoting      IdentQuoting     // identifiers quoting mode
+	maxParams    int              // bound parameters limit per query, 0 means dialect default
+}
+
+var (
+	// PostgreSQL defines PostgreSQL dialect using "$N" placeholders.
+	PostgreSQL = Dialect{kind: kindPostgreSQL, placeholders: DollarPlaceholders}
+
+	// MySQL defines MySQL (or MariaDB) dialect using "?" placeholders.
+	MySQL = Dialect{kind: kindMySQL, placeholders: QuestionPlaceholders}
+
+	// SQLite defines SQLite dialect using "?" placeholders.
+	SQLite = Dialect{kind: kindSQLite, placeholders: QuestionPlaceholders}
+
+	// SQLServer defines Microsoft SQL Server dialect using "@pN" placeholders.
+	SQLServer = Dialect{kind: kindSQLServer, placeholders: AtPlaceholders}
+
+	// Oracle defines Oracle dialect using ":N" placeholders.
+	Oracle = Dialect{kind: kindOracle, placeholders: ColonPlaceholders}
+
+	// rawDialect renders queries same way as PostgreSQL but with "?" placeholders.
+	// Used by RawClauseRenderer implementations.
+	rawDialect = PostgreSQL.WithPlaceholders(QuestionPlaceholders)
+)
+
+// String returns dialect database name.
+// Implements fmt.Stringer.
+func (dialect Dialect) String() string {
+	switch dialect.kind {
+	case kindPostgreSQL:
+		return "PostgreSQL"
+	case kindMySQL:
+		return "MySQL"
+	case kindSQLite:
+		return "SQLite"
+	case kindSQLServer:
+		return "SQLServer"
+	case kindOracle:
+		return "Oracle"
+	default:
+		return "unknown dialect(" + strconv.Itoa(int(dialect.kind)) + ")"
+	}
+}
+
+// Placeholders returns parameters substitution style used by dialect.
+func (dialect Dialect) Placeholders() PlaceholderStyle {
+	return dialect.placeholders
+}
+
+// WithPlaceholders returns a copy of Dialect having parameters substitution style set to specified value.
+// Could be used when database driver expects non default placeholders, i.e. PostgreSQL driver expecting "?".
+func (dialect Dialect) WithPlaceholders(style PlaceholderStyle) Dialect {
+	dialect.placeholders = style
+	return dialect
+}
+
+// Placeholder renders single parameter substitution for specified parameter position.
+// Parameters positions are started from 1.
+func (dialect Dialect) Placeholder(position int) string {
+	return dialect.placeholders.Placeholder(position)
+}
+
+// MaxParameters returns maximum number of bound parameters allowed in single query.
+// Returns value set with WithMaxParameters or dialect default otherwise:
+// 65535 for PostgreSQL, MySQL and Oracle, 32766 for SQLite and 2100 for SQL Server.
+func (dialect Dialect) MaxParameters() int {
+	if dialect.maxParams > 0 {
+		return dialect.maxParams
+	}
+
+	switch dialect.kind {
+	case kindSQLite:
+		return 32766
+	case kindSQLServer:
+		return 2100
+	default:
+		return 65535
+	}
+}
+
+// WithMaxParameters returns a copy of Dialect having bound parameters limit set to specified value.
+// Use it when database is configured with non default limit, i.e. SQLite before 3.32 allows only 999 parameters.
+// Zero or negative value resets the limit to dialect default.
+func (dialect Dialect) WithMaxParameters(limit int) Dialect {
+	if limit < 0 {
+		limit = 0
+	}
+
+	dialect.maxParams = limit
+
+	return dialect
+}
+
+// limitBeforeOffset returns true if dialect requires LIMIT to be rendered before OFFSET.
+// Used to keep parameters order the same as their substitutions order.
+func (dialect Dialect) limitBeforeOffset() bool {
+	return dialect.kind == kindMySQL || dialect.kind == kindSQLite
+}
+
+// renderPagination renders result rows limiting clause from already rendered offset and limit tokens.
+// Tokens could be either placeholders or literal values, empty token means corresponding part is not required.
+// Ordered indicates ORDER BY clause is already rendered, some dialects require it to use pagination.
+// Returns empty string if both tokens are empty.
+func (dialect Dialect) renderPagination(ordered bool, offset string, limit string) string {
+	if len(offset) == 0 && len(limit) == 0 {
+		return ""
+	}
+
+	tokens := make([]string, 0, 8)
+
+	switch dialect.kind {
+	case kindMySQL, kindSQLite:
+		switch {
+		case len(limit) > 0:
+			tokens = append(tokens, "LIMIT", limit)
+		case dialect.kind == kindMySQL: // MySQL has no OFFSET without LIMIT, use max rows count
+			tokens = append(tokens, "LIMIT", "18446744073709551615")
+		default: // SQLite treats negative limit as no limit
+			tokens = append(tokens, "LIMIT", "-1")
+		}
+
+		if len(offset) > 0 {
+			tokens = append(tokens, "OFFSET", offset)
+		}
+	case kindSQLServer, kindOracle:
+		if !ordered && dialect.kind == kindSQLServer { // SQL Server allows OFFSET only after ORDER BY
+			tokens = append(tokens, "ORDER BY (SELECT NULL)")
+		}
+
+		if len(offset) == 0 {
+			offset = "0"
+		}
+
+		tokens = append(tokens, "OFFSET", offset, "ROWS")
+
+		if len(limit) > 0 {
+			tokens = append(tokens, "FETCH NEXT", limit, "ROWS ONLY")
+		}
+	default:
+		if len(offset) > 0 {
+			tokens = append(tokens, "OFFSET", offset)
+		}
+
+		if len(limit) > 0 {
+			tokens = append(tokens, "LIMIT", limit)
+		}
+	}
+
+	return strings.Join(tokens, " ")
+}
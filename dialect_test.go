@@ -0,0 +1,192 @@
+package query_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/query"
+)
+
+func TestPlaceholderStyle_Placeholder(t *testing.T) {
+	tests := []struct {
+		name     string
+		style    query.PlaceholderStyle
+		position int
+		want     string
+	}{
+		{"dollar", query.DollarPlaceholders, 3, "$3"},
+		{"question", query.QuestionPlaceholders, 3, "?"},
+		{"at", query.AtPlaceholders, 3, "@p3"},
+		{"colon", query.ColonPlaceholders, 3, ":3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.style.Placeholder(tt.position))
+		})
+	}
+}
+
+func TestDialect_WithPlaceholders(t *testing.T) {
+	var zero query.Dialect
+
+	require.Equal(t, query.PostgreSQL, zero)
+	require.Equal(t, query.DollarPlaceholders, query.PostgreSQL.Placeholders())
+
+	updated := query.PostgreSQL.WithPlaceholders(query.QuestionPlaceholders)
+	require.Equal(t, query.QuestionPlaceholders, updated.Placeholders())
+	require.Equal(t, query.DollarPlaceholders, query.PostgreSQL.Placeholders(), "original dialect changed")
+	require.Equal(t, "PostgreSQL", updated.String())
+}
+
+func TestBuildQueryAndParamsFor(t *testing.T) {
+	selectMany := query.SelectManyFrom("t1").
+		Where(query.EqualTo("f1", 1), query.In("f2", []string{"a", "b"})).
+		OrderBy(query.ASC("f1")).
+		Offset(10).
+		Limit(5)
+	selectPage := query.SelectManyFrom("t1").Where(query.EqualTo("f1", 1)).Limit(5)
+	selectSkip := query.SelectManyFrom("t1").Where(query.EqualTo("f1", 1)).Offset(10)
+	selectOne := query.SelectSingleFrom("t1").Where(query.EqualTo("f1", 1))
+	count := query.SelectFrom("t1").Where(query.GreaterThan("f1", 1)).Count()
+	insert := query.InsertInto("t1").Values(query.FieldName("f1").Value(1), query.FieldName("f2").Value("a"))
+	update := query.Update("t1").Set(query.FieldName("f1").Value(1)).Where(query.Less("f2", 2))
+	del := query.Delete("t1").Where(query.EqualTo("f1", 1), query.Or(query.EqualTo("f2", 2)))
+
+	tests := []struct {
+		name       string
+		builder    query.QueryBuilder
+		dialect    query.Dialect
+		wantSQL    string
+		wantParams []any
+	}{
+		{
+			"select_many_postgres", selectMany, query.PostgreSQL,
+			"SELECT * FROM t1 WHERE f1=$1 AND f2 IN ($2,$3) ORDER BY f1 ASC OFFSET $4 LIMIT $5",
+			[]any{1, "a", "b", uint(10), uint(5)},
+		},
+		{
+			"select_many_mysql", selectMany, query.MySQL,
+			"SELECT * FROM t1 WHERE f1=? AND f2 IN (?,?) ORDER BY f1 ASC LIMIT ? OFFSET ?",
+			[]any{1, "a", "b", uint(5), uint(10)},
+		},
+		{
+			"select_many_sqlite", selectMany, query.SQLite,
+			"SELECT * FROM t1 WHERE f1=? AND f2 IN (?,?) ORDER BY f1 ASC LIMIT ? OFFSET ?",
+			[]any{1, "a", "b", uint(5), uint(10)},
+		},
+		{
+			"select_many_sql_server", selectMany, query.SQLServer,
+			"SELECT * FROM t1 WHERE f1=@p1 AND f2 IN (@p2,@p3) ORDER BY f1 ASC " +
+				"OFFSET @p4 ROWS FETCH NEXT @p5 ROWS ONLY",
+			[]any{1, "a", "b", uint(10), uint(5)},
+		},
+		{
+			"select_many_oracle", selectMany, query.Oracle,
+			"SELECT * FROM t1 WHERE f1=:1 AND f2 IN (:2,:3) ORDER BY f1 ASC OFFSET :4 ROWS FETCH NEXT :5 ROWS ONLY",
+			[]any{1, "a", "b", uint(10), uint(5)},
+		},
+		{
+			"select_page_sql_server_unordered", selectPage, query.SQLServer,
+			"SELECT * FROM t1 WHERE f1=@p1 ORDER BY (SELECT NULL) OFFSET 0 ROWS FETCH NEXT @p2 ROWS ONLY",
+			[]any{1, uint(5)},
+		},
+		{
+			"select_skip_mysql", selectSkip, query.MySQL,
+			"SELECT * FROM t1 WHERE f1=? LIMIT 18446744073709551615 OFFSET ?",
+			[]any{1, uint(10)},
+		},
+		{
+			"select_skip_sqlite", selectSkip, query.SQLite,
+			"SELECT * FROM t1 WHERE f1=? LIMIT -1 OFFSET ?",
+			[]any{1, uint(10)},
+		},
+		{
+			"select_one_postgres_questions", selectOne, query.PostgreSQL.WithPlaceholders(query.QuestionPlaceholders),
+			"SELECT * FROM t1 WHERE f1=? LIMIT 1",
+			[]any{1},
+		},
+		{
+			"select_one_oracle", selectOne, query.Oracle,
+			"SELECT * FROM t1 WHERE f1=:1 OFFSET 0 ROWS FETCH NEXT 1 ROWS ONLY",
+			[]any{1},
+		},
+		{
+			"count_sqlite", count, query.SQLite,
+			"SELECT COUNT(*) FROM t1 WHERE f1>?",
+			[]any{1},
+		},
+		{
+			"insert_sql_server", insert, query.SQLServer,
+			"INSERT INTO t1(f1, f2) VALUES (@p1, @p2)",
+			[]any{1, "a"},
+		},
+		{
+			"update_oracle", update, query.Oracle,
+			"UPDATE t1 SET f1=:1 WHERE f2<:2",
+			[]any{1, 2},
+		},
+		{
+			"delete_mysql", del, query.MySQL,
+			"DELETE FROM t1 WHERE f1=? OR f2=?",
+			[]any{1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSQL, gotParams, err := tt.builder.BuildQueryAndParamsFor(tt.dialect)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSQL, gotSQL)
+			require.Equal(t, tt.wantParams, gotParams)
+		})
+	}
+}
+
+func TestDialect_MaxParameters(t *testing.T) {
+	require.Equal(t, 65535, query.PostgreSQL.MaxParameters())
+	require.Equal(t, 32766, query.SQLite.MaxParameters())
+	require.Equal(t, 999, query.SQLite.WithMaxParameters(999).MaxParameters())
+	require.Equal(t, 32766, query.SQLite.WithMaxParameters(999).WithMaxParameters(0).MaxParameters())
+}
+
+// customCondition implements Condition outside the package without RenderDialect.
+type customCondition struct {
+	query.Condition
+}
+
+func (customCondition) Render(parametersCount int) string {
+	return fmt.Sprintf("LOWER(name)=$%d OR note='?'", parametersCount+1)
+}
+
+func (customCondition) RenderSQL() string {
+	return "LOWER(name)=? OR note='?'"
+}
+
+func (customCondition) Values() []any {
+	return []any{"bob"}
+}
+
+func TestBuildQueryAndParamsFor_CustomCondition(t *testing.T) {
+	custom := customCondition{Condition: query.EqualTo("name", "bob")}
+	builder := query.SelectManyFrom("users").Where(query.EqualTo("active", true), custom)
+
+	tests := []struct {
+		name    string
+		dialect query.Dialect
+		wantSQL string
+	}{
+		{"postgres", query.PostgreSQL, "SELECT * FROM users WHERE active=$1 AND LOWER(name)=$2 OR note='?'"},
+		{"mysql", query.MySQL, "SELECT * FROM users WHERE active=? AND LOWER(name)=? OR note='?'"},
+		{"sql_server", query.SQLServer, "SELECT * FROM users WHERE active=@p1 AND LOWER(name)=@p2 OR note='?'"},
+		{"oracle", query.Oracle, "SELECT * FROM users WHERE active=:1 AND LOWER(name)=:2 OR note='?'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, params, err := builder.BuildQueryAndParamsFor(tt.dialect)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSQL, sql)
+			require.Equal(t, []any{true, "bob"}, params)
+		})
+	}
+}
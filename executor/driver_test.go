@@ -0,0 +1,101 @@
+package executor_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+)
+
+// fakeResult defines scripted response to single query or statement.
+type fakeResult struct {
+	columns  []string
+	rows     [][]driver.Value
+	affected int64
+}
+
+// fakeCall stores executed query and its arguments.
+type fakeCall struct {
+	query string
+	args  []any
+}
+
+// fakeDB implements driver.Connector returning scripted results in order of queries execution.
+type fakeDB struct {
+	results []fakeResult
+	calls   []fakeCall
+}
+
+func (db *fakeDB) Connect(context.Context) (driver.Conn, error) { return &fakeConn{db: db}, nil }
+func (db *fakeDB) Driver() driver.Driver                        { return nil }
+
+// next records call and returns the next scripted result.
+func (db *fakeDB) next(query string, args []driver.NamedValue) (fakeResult, error) {
+	call := fakeCall{query: query, args: make([]any, len(args))}
+	for idx, arg := range args {
+		call.args[idx] = arg.Value
+	}
+
+	db.calls = append(db.calls, call)
+
+	if len(db.results) == 0 {
+		return fakeResult{}, errors.New("unexpected query " + query)
+	}
+
+	result := db.results[0]
+	db.results = db.results[1:]
+
+	return result, nil
+}
+
+// openFake makes *sql.DB over fakeDB returning specified results in order.
+func openFake(results ...fakeResult) (*sql.DB, *fakeDB) {
+	db := &fakeDB{results: results}
+	return sql.OpenDB(db), db
+}
+
+type fakeConn struct{ db *fakeDB }
+
+func (conn *fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (conn *fakeConn) Close() error                        { return nil }
+func (conn *fakeConn) Begin() (driver.Tx, error)           { return conn, nil }
+func (conn *fakeConn) Commit() error                       { return nil }
+func (conn *fakeConn) Rollback() error                     { return nil }
+
+func (conn *fakeConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	result, err := conn.db.next(query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fakeRows{result: result}, nil
+}
+
+func (conn *fakeConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	result, err := conn.db.next(query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return driver.RowsAffected(result.affected), nil
+}
+
+type fakeRows struct {
+	result fakeResult
+	pos    int
+}
+
+func (rows *fakeRows) Columns() []string { return rows.result.columns }
+func (rows *fakeRows) Close() error      { return nil }
+
+func (rows *fakeRows) Next(dest []driver.Value) error {
+	if rows.pos >= len(rows.result.rows) {
+		return io.EOF
+	}
+
+	copy(dest, rows.result.rows[rows.pos])
+	rows.pos++
+
+	return nil
+}
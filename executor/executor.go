@@ -0,0 +1,234 @@
+// Package executor implements query package Fetcher, Getter, Inserter, Updater, Deleter and Counter
+// interfaces over standard database/sql connections, transactions and pools.
+package executor
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/amarin/query"
+)
+
+// Conn requires implementation could execute queries using database/sql API.
+// Implemented by *sql.DB, *sql.Tx and *sql.Conn.
+type Conn interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+var (
+	_ Conn = (*sql.DB)(nil)
+	_ Conn = (*sql.Tx)(nil)
+	_ Conn = (*sql.Conn)(nil)
+
+	_ query.Fetcher      = Executor{}
+	_ query.Getter       = Executor{}
+	_ query.Inserter     = Executor{}
+	_ query.Updater      = Executor{}
+	_ query.Deleter      = Executor{}
+	_ query.Counter      = Executor{}
+	_ query.FetchCounter = Executor{}
+)
+
+// totalCountAlias is column alias of total records count selected by FetchTotalCtx.
+const totalCountAlias query.FieldName = "total_rows_count"
+
+// Executor runs queries built with query package builders using underlying Conn.
+// Queries are rendered with Dialect specified at construction.
+// Executor is immutable and safe to share between goroutines as long as Conn is.
+type Executor struct {
+	conn    Conn
+	dialect query.Dialect
+}
+
+// New makes a new Executor running queries over specified Conn, i.e. *sql.DB, *sql.Tx or *sql.Conn,
+// rendered with specified Dialect placeholders and quoting.
+func New(conn Conn, dialect query.Dialect) Executor {
+	return Executor{conn: conn, dialect: dialect}
+}
+
+// Dialect returns Dialect used to render queries.
+func (executor Executor) Dialect() query.Dialect {
+	return executor.dialect
+}
+
+// WithConn returns a copy of Executor running queries over specified Conn, i.e. to run them inside transaction.
+func (executor Executor) WithConn(conn Conn) Executor {
+	executor.conn = conn
+	return executor
+}
+
+// QueryCtx runs query built by specified builder and scans all result rows into target.
+// Target should be a pointer to slice of structs, struct pointers, maps or scalar values.
+func (executor Executor) QueryCtx(ctx context.Context, builder query.QueryBuilder, target any) (err error) {
+	var rows *sql.Rows
+
+	if rows, err = executor.RowsCtx(ctx, builder); err != nil {
+		return err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	return ScanAll(rows, target)
+}
+
+// RowsCtx runs query built by specified builder and returns result rows as is.
+// Caller is responsible to close rows.
+func (executor Executor) RowsCtx(ctx context.Context, builder query.QueryBuilder) (rows *sql.Rows, err error) {
+	var (
+		sqlString string
+		params    []any
+	)
+
+	if sqlString, params, err = builder.BuildQueryAndParamsFor(executor.dialect); err != nil {
+		return nil, err
+	}
+
+	return executor.conn.QueryContext(ctx, sqlString, params...)
+}
+
+// ExecCtx runs statement built by specified builder and returns affected rows count.
+func (executor Executor) ExecCtx(ctx context.Context, builder query.QueryBuilder) (affectedRows int, err error) {
+	var (
+		sqlString string
+		params    []any
+		result    sql.Result
+		affected  int64
+	)
+
+	if sqlString, params, err = builder.BuildQueryAndParamsFor(executor.dialect); err != nil {
+		return 0, err
+	}
+
+	if result, err = executor.conn.ExecContext(ctx, sqlString, params...); err != nil {
+		return 0, err
+	}
+
+	if affected, err = result.RowsAffected(); err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
+
+// FetchCtx fetches records selected by SelectManyBuilder into target.
+// Target should be a pointer to slice of structs, struct pointers, maps or scalar values.
+// Implements query.Fetcher.
+func (executor Executor) FetchCtx(ctx context.Context, queryParams query.SelectManyBuilder, target any) error {
+	return executor.QueryCtx(ctx, queryParams, target)
+}
+
+// GetCtx gets single record selected by SelectSingleBuilder into target.
+// Target should be a pointer to struct, map or scalar value.
+// Returns error matching sql.ErrNoRows with errors.Is if no record found.
+// Implements query.Getter.
+func (executor Executor) GetCtx(ctx context.Context, queryParams query.SelectSingleBuilder, target any) error {
+	return executor.getCtx(ctx, queryParams, target)
+}
+
+// getCtx runs query built by specified builder and scans the first result row into target.
+func (executor Executor) getCtx(ctx context.Context, builder query.QueryBuilder, target any) (err error) {
+	var rows *sql.Rows
+
+	if rows, err = executor.RowsCtx(ctx, builder); err != nil {
+		return err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	return ScanOne(rows, target)
+}
+
+// InsertOneCtx inserts record using InsertBuilder.
+// Returns error if insert requires several statements due to Dialect parameters limit.
+// Implements query.Inserter.
+func (executor Executor) InsertOneCtx(ctx context.Context, updateParams query.InsertBuilder) (err error) {
+	_, err = executor.ExecCtx(ctx, updateParams)
+	return err
+}
+
+// UpdateOneCtx updates record using UpdateBuilder.
+// Returns error matching sql.ErrNoRows with errors.Is if no record updated.
+// Updated records are detected by affected rows count reported by driver. MySQL drivers report rows
+// actually changed unless CLIENT_FOUND_ROWS flag is set (clientFoundRows=true DSN parameter of go-sql-driver/mysql),
+// so update setting the same values as stored ones returns sql.ErrNoRows error too.
+// Implements query.Updater.
+func (executor Executor) UpdateOneCtx(ctx context.Context, updateParams query.UpdateBuilder) (err error) {
+	var affectedRows int
+
+	if affectedRows, err = executor.ExecCtx(ctx, updateParams); err != nil {
+		return err
+	}
+
+	if affectedRows == 0 {
+		return fmt.Errorf("%w: no records updated in %v", sql.ErrNoRows, updateParams.TableName())
+	}
+
+	return nil
+}
+
+// DeleteManyCtx deletes records using DeleteBuilder and returns deleted records count.
+// Implements query.Deleter.
+func (executor Executor) DeleteManyCtx(ctx context.Context, updateParams query.DeleteBuilder) (int, error) {
+	return executor.ExecCtx(ctx, updateParams)
+}
+
+// CountCtx returns records count using CountBuilder.
+// Implements query.Counter.
+func (executor Executor) CountCtx(ctx context.Context, helper query.CountBuilder) (rowsCount int, err error) {
+	err = executor.getCtx(ctx, helper, &rowsCount)
+
+	return rowsCount, err
+}
+
+// FetchCountCtx fetches records selected by SelectManyBuilder into target
+// and returns total records count matching query conditions regardless of offset and limit.
+// Runs count query built by SelectManyBuilder.Count before records query, so total count could disagree with
+// fetched records if table is changed in between. Run it over transaction having suitable isolation level
+// using WithConn or use FetchTotalCtx to get both in the same statement.
+// Implements query.FetchCounter.
+func (executor Executor) FetchCountCtx(
+	ctx context.Context, queryParams query.SelectManyBuilder, target any,
+) (totalRows int, err error) {
+	if totalRows, err = executor.CountCtx(ctx, queryParams.Count()); err != nil {
+		return 0, err
+	}
+
+	if err = executor.FetchCtx(ctx, queryParams, target); err != nil {
+		return 0, err
+	}
+
+	return totalRows, nil
+}
+
+// FetchTotalCtx fetches records selected by SelectManyBuilder into target and returns total records count
+// matching query conditions regardless of offset and limit in the same statement using COUNT(*) OVER () window
+// function, see SelectManyBuilder.WithTotalCount. Total count column is not scanned into target.
+// Falls back to count query if no records fetched, i.e. offset exceeds total count.
+// Note distinct rows queries are not supported and keyset cursor conditions restrict counted records,
+// use FetchCountCtx in such cases.
+func (executor Executor) FetchTotalCtx(
+	ctx context.Context, queryParams query.SelectManyBuilder, target any,
+) (totalRows int, err error) {
+	var (
+		rows    *sql.Rows
+		fetched int
+	)
+
+	if rows, err = executor.RowsCtx(ctx, queryParams.WithTotalCount(totalCountAlias)); err != nil {
+		return 0, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	if fetched, err = scanAll(rows, target, &totalRows); err != nil {
+		return 0, err
+	}
+
+	if fetched == 0 {
+		return executor.CountCtx(ctx, queryParams.Count())
+	}
+
+	return totalRows, nil
+}
@@ -0,0 +1,179 @@
+package executor_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/query"
+	"github.com/amarin/query/executor"
+)
+
+type user struct {
+	ID    int64
+	Name  string         `db:"user_name"`
+	Email sql.NullString `db:"email"`
+	Note  string         `db:"-"`
+}
+
+func TestExecutor_FetchCtx(t *testing.T) {
+	db, fake := openFake(fakeResult{
+		columns: []string{"id", "user_name", "email"},
+		rows:    [][]driver.Value{{int64(1), "alice", "a@example.com"}, {int64(2), "bob", nil}},
+	})
+
+	var users []user
+
+	err := executor.New(db, query.MySQL).FetchCtx(context.Background(),
+		query.SelectManyFrom("users").Where(query.GreaterThan("id", 0)).Limit(2), &users)
+	require.NoError(t, err)
+	require.Equal(t, []user{
+		{ID: 1, Name: "alice", Email: sql.NullString{String: "a@example.com", Valid: true}},
+		{ID: 2, Name: "bob"},
+	}, users)
+	require.Equal(t, "SELECT * FROM users WHERE id>? LIMIT ?", fake.calls[0].query)
+	require.Equal(t, []any{int64(0), int64(2)}, fake.calls[0].args)
+}
+
+func TestExecutor_FetchCtx_Targets(t *testing.T) {
+	rows := fakeResult{columns: []string{"id"}, rows: [][]driver.Value{{int64(1)}, {int64(2)}}}
+	ctx := context.Background()
+	builder := query.SelectManyFrom("users").Fields(query.Field("id"))
+
+	t.Run("scalars", func(t *testing.T) {
+		db, _ := openFake(rows)
+		var ids []int
+		require.NoError(t, executor.New(db, query.PostgreSQL).FetchCtx(ctx, builder, &ids))
+		require.Equal(t, []int{1, 2}, ids)
+	})
+
+	t.Run("struct_pointers", func(t *testing.T) {
+		db, _ := openFake(rows)
+		var users []*user
+		require.NoError(t, executor.New(db, query.PostgreSQL).FetchCtx(ctx, builder, &users))
+		require.Equal(t, []*user{{ID: 1}, {ID: 2}}, users)
+	})
+
+	t.Run("maps", func(t *testing.T) {
+		db, _ := openFake(rows)
+		var items []map[string]any
+		require.NoError(t, executor.New(db, query.PostgreSQL).FetchCtx(ctx, builder, &items))
+		require.Equal(t, []map[string]any{{"id": int64(1)}, {"id": int64(2)}}, items)
+	})
+
+	t.Run("not_slice", func(t *testing.T) {
+		db, _ := openFake(rows)
+		var item user
+		require.ErrorIs(t, executor.New(db, query.PostgreSQL).FetchCtx(ctx, builder, &item), query.Error)
+	})
+
+	t.Run("unmapped_column", func(t *testing.T) {
+		db, _ := openFake(fakeResult{columns: []string{"id", "age"}, rows: [][]driver.Value{{int64(1), int64(2)}}})
+		var users []user
+		require.ErrorIs(t, executor.New(db, query.PostgreSQL).FetchCtx(ctx, builder, &users), query.Error)
+	})
+}
+
+func TestExecutor_GetCtx(t *testing.T) {
+	ctx := context.Background()
+	builder := query.SelectSingleFrom("users").Where(query.EqualTo("id", 1))
+
+	db, fake := openFake(
+		fakeResult{columns: []string{"id", "user_name"}, rows: [][]driver.Value{{int64(1), "alice"}}},
+		fakeResult{columns: []string{"id", "user_name"}},
+	)
+	exec := executor.New(db, query.PostgreSQL)
+
+	var found user
+	require.NoError(t, exec.GetCtx(ctx, builder, &found))
+	require.Equal(t, user{ID: 1, Name: "alice"}, found)
+	require.Equal(t, "SELECT * FROM users WHERE id=$1 LIMIT 1", fake.calls[0].query)
+
+	require.ErrorIs(t, exec.GetCtx(ctx, builder, &found), sql.ErrNoRows)
+}
+
+func TestExecutor_Modify(t *testing.T) {
+	ctx := context.Background()
+	db, fake := openFake(
+		fakeResult{affected: 1},
+		fakeResult{affected: 1},
+		fakeResult{affected: 0},
+		fakeResult{affected: 3},
+	)
+	exec := executor.New(db, query.PostgreSQL)
+
+	require.NoError(t, exec.InsertOneCtx(ctx, query.InsertInto("users").Values(query.FieldName("id").Value(1))))
+	require.Equal(t, "INSERT INTO users(id) VALUES ($1)", fake.calls[0].query)
+
+	update := query.Update("users").Set(query.FieldName("name").Value("bob")).Where(query.EqualTo("id", 1))
+	require.NoError(t, exec.UpdateOneCtx(ctx, update))
+	require.ErrorIs(t, exec.UpdateOneCtx(ctx, update), sql.ErrNoRows)
+
+	affected, err := exec.DeleteManyCtx(ctx, query.Delete("users").Where(query.GreaterThan("id", 10)))
+	require.NoError(t, err)
+	require.Equal(t, 3, affected)
+	require.Equal(t, "DELETE FROM users WHERE id>$1", fake.calls[3].query)
+}
+
+func TestExecutor_FetchCountCtx(t *testing.T) {
+	db, fake := openFake(
+		fakeResult{columns: []string{"count"}, rows: [][]driver.Value{{int64(42)}}},
+		fakeResult{columns: []string{"id"}, rows: [][]driver.Value{{int64(11)}}},
+	)
+
+	var ids []int64
+
+	total, err := executor.New(db, query.PostgreSQL).FetchCountCtx(context.Background(),
+		query.SelectManyFrom("users").Fields(query.Field("id")).
+			Where(query.GreaterThan("id", 10)).OrderBy(query.ASC("id")).Offset(10).Limit(1), &ids)
+	require.NoError(t, err)
+	require.Equal(t, 42, total)
+	require.Equal(t, []int64{11}, ids)
+	require.Equal(t, "SELECT COUNT(*) FROM users WHERE id>$1", fake.calls[0].query)
+	require.Equal(t, "SELECT id FROM users WHERE id>$1 ORDER BY id ASC OFFSET $2 LIMIT $3", fake.calls[1].query)
+}
+
+func TestExecutor_FetchTotalCtx(t *testing.T) {
+	type user struct {
+		ID   int64
+		Name string
+	}
+
+	builder := query.SelectManyFrom("users").Where(query.GreaterThan("id", 10)).
+		OrderBy(query.ASC("id")).Offset(10).Limit(2)
+
+	t.Run("single_statement", func(t *testing.T) {
+		db, fake := openFake(fakeResult{
+			columns: []string{"id", "name", "total_rows_count"},
+			rows:    [][]driver.Value{{int64(11), "ann", int64(42)}, {int64(12), "bob", int64(42)}},
+		})
+
+		var users []user
+
+		total, err := executor.New(db, query.PostgreSQL).FetchTotalCtx(context.Background(), builder, &users)
+		require.NoError(t, err)
+		require.Equal(t, 42, total)
+		require.Equal(t, []user{{ID: 11, Name: "ann"}, {ID: 12, Name: "bob"}}, users)
+		require.Len(t, fake.calls, 1)
+		require.Equal(t, "SELECT *, COUNT(*) OVER () AS total_rows_count FROM users WHERE id>$1 "+
+			"ORDER BY id ASC OFFSET $2 LIMIT $3", fake.calls[0].query)
+	})
+
+	t.Run("empty_page_counted", func(t *testing.T) {
+		db, fake := openFake(
+			fakeResult{columns: []string{"id", "name", "total_rows_count"}},
+			fakeResult{columns: []string{"count"}, rows: [][]driver.Value{{int64(7)}}},
+		)
+
+		var users []user
+
+		total, err := executor.New(db, query.PostgreSQL).FetchTotalCtx(context.Background(), builder, &users)
+		require.NoError(t, err)
+		require.Equal(t, 7, total)
+		require.Empty(t, users)
+		require.Equal(t, "SELECT COUNT(*) FROM users WHERE id>$1", fake.calls[1].query)
+	})
+}
@@ -0,0 +1,65 @@
+package executor
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+
+	"github.com/amarin/query"
+)
+
+// FetchAll fetches all records selected by SelectManyBuilder as a slice of T.
+// T could be a struct, struct pointer, map having string keys or scalar type, see ScanAll for mapping rules.
+func FetchAll[T any](ctx context.Context, executor Executor, builder query.SelectManyBuilder) (items []T, err error) {
+	items = make([]T, 0)
+
+	if err = executor.FetchCtx(ctx, builder, &items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// GetOne gets single record selected by SelectSingleBuilder as T.
+// Returns error matching sql.ErrNoRows with errors.Is if no record found.
+func GetOne[T any](ctx context.Context, executor Executor, builder query.SelectSingleBuilder) (item T, err error) {
+	if err = executor.GetCtx(ctx, builder, &item); err != nil {
+		var empty T
+		return empty, err
+	}
+
+	return item, nil
+}
+
+// Each streams records selected by query builder calling fn for every record scanned as T
+// without buffering the whole result set. Stops on the first fn error and returns it.
+func Each[T any](ctx context.Context, executor Executor, builder query.QueryBuilder, fn func(item T) error) (err error) {
+	var (
+		rows    *sql.Rows
+		columns []string
+	)
+
+	if rows, err = executor.RowsCtx(ctx, builder); err != nil {
+		return err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	if columns, err = rows.Columns(); err != nil {
+		return err
+	}
+
+	for rows.Next() {
+		var item T
+
+		if err = scanRow(rows, columns, reflect.ValueOf(&item).Elem()); err != nil {
+			return err
+		}
+
+		if err = fn(item); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,80 @@
+package executor_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/query"
+	"github.com/amarin/query/executor"
+)
+
+func TestFetchAll(t *testing.T) {
+	db, _ := openFake(
+		fakeResult{columns: []string{"id", "user_name"}, rows: [][]driver.Value{{int64(1), "alice"}, {int64(2), "bob"}}},
+		fakeResult{columns: []string{"id"}},
+	)
+	exec := executor.New(db, query.PostgreSQL)
+
+	users, err := executor.FetchAll[user](context.Background(), exec, query.SelectManyFrom("users"))
+	require.NoError(t, err)
+	require.Equal(t, []user{{ID: 1, Name: "alice"}, {ID: 2, Name: "bob"}}, users)
+
+	ids, err := executor.FetchAll[int64](context.Background(), exec, query.SelectManyFrom("users"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{}, ids)
+}
+
+func TestGetOne(t *testing.T) {
+	db, _ := openFake(
+		fakeResult{columns: []string{"id", "user_name"}, rows: [][]driver.Value{{int64(1), "alice"}}},
+		fakeResult{columns: []string{"id", "user_name"}},
+	)
+	exec := executor.New(db, query.PostgreSQL)
+	builder := query.SelectSingleFrom("users").Where(query.EqualTo("id", 1))
+
+	found, err := executor.GetOne[*user](context.Background(), exec, builder)
+	require.NoError(t, err)
+	require.Equal(t, &user{ID: 1, Name: "alice"}, found)
+
+	found, err = executor.GetOne[*user](context.Background(), exec, builder)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+	require.Nil(t, found)
+}
+
+func TestEach(t *testing.T) {
+	rows := fakeResult{columns: []string{"id"}, rows: [][]driver.Value{{int64(1)}, {int64(2)}, {int64(3)}}}
+	stop := errors.New("stop")
+
+	t.Run("all", func(t *testing.T) {
+		db, _ := openFake(rows)
+		seen := make([]int, 0)
+		err := executor.Each(context.Background(), executor.New(db, query.PostgreSQL), query.SelectManyFrom("users"),
+			func(id int) error {
+				seen = append(seen, id)
+				return nil
+			})
+		require.NoError(t, err)
+		require.Equal(t, []int{1, 2, 3}, seen)
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		db, _ := openFake(rows)
+		seen := make([]map[string]any, 0)
+		err := executor.Each(context.Background(), executor.New(db, query.PostgreSQL), query.SelectManyFrom("users"),
+			func(item map[string]any) error {
+				seen = append(seen, item)
+				if len(seen) == 2 {
+					return stop
+				}
+
+				return nil
+			})
+		require.ErrorIs(t, err, stop)
+		require.Equal(t, []map[string]any{{"id": int64(1)}, {"id": int64(2)}}, seen)
+	})
+}
@@ -0,0 +1,20 @@
+package executor
+
+import "reflect"
+
+// fieldByIndex returns struct field by query.StructColumn index path allocating nil embedded struct pointers on the way.
+func fieldByIndex(value reflect.Value, index []int) reflect.Value {
+	for pos, idx := range index {
+		if pos > 0 && value.Kind() == reflect.Pointer {
+			if value.IsNil() {
+				value.Set(reflect.New(value.Type().Elem()))
+			}
+
+			value = value.Elem()
+		}
+
+		value = value.Field(idx)
+	}
+
+	return value
+}
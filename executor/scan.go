@@ -0,0 +1,164 @@
+package executor
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/amarin/query"
+)
+
+var scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+
+// ScanAll scans all rows into target which should be a non-nil pointer to slice
+// of structs, struct pointers, maps having string keys or scalar values.
+// Struct fields are matched to columns by `db:"column"` tag or field name converted to snake case, i.e. UserID to user_id.
+// Use FieldDefinition.As to alias columns matching desired fields.
+// Untagged embedded structs are flattened, pointer fields receive nil on NULL and sql.Scanner fields scan themselves.
+// Returns error listing all columns having no matching fields.
+// Note ScanAll does not close rows.
+func ScanAll(rows *sql.Rows, target any) (err error) {
+	_, err = scanAll(rows, target)
+	return err
+}
+
+// scanAll scans all rows into target slice and returns scanned rows count.
+// Takes destinations of trailing columns to scan into every row instead of target items, i.e. window function results.
+func scanAll(rows *sql.Rows, target any, trailing ...any) (count int, err error) {
+	var columns []string
+
+	targetValue := reflect.ValueOf(target)
+	if targetValue.Kind() != reflect.Pointer || targetValue.IsNil() || targetValue.Elem().Kind() != reflect.Slice {
+		return 0, fmt.Errorf("%w: target should be a non-nil pointer to slice, not %T", query.Error, target)
+	}
+
+	if columns, err = rows.Columns(); err != nil {
+		return 0, err
+	}
+
+	if len(columns) < len(trailing) {
+		return 0, fmt.Errorf("%w: %d columns selected while %d trailing expected", query.Error, len(columns), len(trailing))
+	}
+
+	columns = columns[:len(columns)-len(trailing)]
+	slice := targetValue.Elem()
+	slice.SetLen(0)
+
+	for rows.Next() {
+		item := reflect.New(slice.Type().Elem()).Elem()
+		if err = scanRow(rows, columns, item, trailing...); err != nil {
+			return 0, err
+		}
+
+		slice.Set(reflect.Append(slice, item))
+	}
+
+	return slice.Len(), rows.Err()
+}
+
+// ScanOne scans the first row into target which should be a non-nil pointer to struct, map or scalar value.
+// Uses the same mapping rules as ScanAll. Returns sql.ErrNoRows if no rows found.
+// Note ScanOne does not close rows.
+func ScanOne(rows *sql.Rows, target any) (err error) {
+	var columns []string
+
+	targetValue := reflect.ValueOf(target)
+	if targetValue.Kind() != reflect.Pointer || targetValue.IsNil() {
+		return fmt.Errorf("%w: target should be a non-nil pointer, not %T", query.Error, target)
+	}
+
+	if columns, err = rows.Columns(); err != nil {
+		return err
+	}
+
+	if !rows.Next() {
+		if err = rows.Err(); err != nil {
+			return err
+		}
+
+		return sql.ErrNoRows
+	}
+
+	if err = scanRow(rows, columns, targetValue.Elem()); err != nil {
+		return err
+	}
+
+	return rows.Err()
+}
+
+// scanRow scans current row into addressable value of struct, map, pointer or scalar type.
+// Takes destinations of trailing columns following specified ones to scan them too.
+func scanRow(rows *sql.Rows, columns []string, value reflect.Value, trailing ...any) error {
+	if value.Kind() == reflect.Pointer && !value.Type().Implements(scannerType) {
+		if value.IsNil() {
+			value.Set(reflect.New(value.Type().Elem()))
+		}
+
+		value = value.Elem()
+	}
+
+	switch {
+	case value.Kind() == reflect.Map:
+		return scanMap(rows, columns, value, trailing)
+	case query.IsMappedStruct(value.Type()):
+		return scanStruct(rows, columns, value, trailing)
+	case len(columns) != 1:
+		return fmt.Errorf("%w: could not scan %d columns into %v", query.Error, len(columns), value.Type())
+	default:
+		return rows.Scan(append([]any{value.Addr().Interface()}, trailing...)...)
+	}
+}
+
+// scanMap scans current row into map having string keys.
+func scanMap(rows *sql.Rows, columns []string, value reflect.Value, trailing []any) error {
+	if value.Type().Key().Kind() != reflect.String {
+		return fmt.Errorf("%w: could not scan into %v, map keys should be strings", query.Error, value.Type())
+	}
+
+	cells := make([]reflect.Value, len(columns))
+	destinations := make([]any, len(columns))
+
+	for idx := range columns {
+		cells[idx] = reflect.New(value.Type().Elem())
+		destinations[idx] = cells[idx].Interface()
+	}
+
+	if err := rows.Scan(append(destinations, trailing...)...); err != nil {
+		return err
+	}
+
+	if value.IsNil() {
+		value.Set(reflect.MakeMapWithSize(value.Type(), len(columns)))
+	}
+
+	for idx, column := range columns {
+		value.SetMapIndex(reflect.ValueOf(column).Convert(value.Type().Key()), cells[idx].Elem())
+	}
+
+	return nil
+}
+
+// scanStruct scans current row into struct fields using cached struct columns mapping, see query.StructColumns.
+// Returns error listing all columns having no matching fields.
+func scanStruct(rows *sql.Rows, columns []string, value reflect.Value, trailing []any) error {
+	destinations := make([]any, len(columns))
+	unmapped := make([]string, 0)
+
+	for idx, column := range columns {
+		structColumn, found := query.StructColumnByName(value.Type(), query.FieldName(column))
+		if !found {
+			unmapped = append(unmapped, column)
+			continue
+		}
+
+		destinations[idx] = fieldByIndex(value, structColumn.Index).Addr().Interface()
+	}
+
+	if len(unmapped) > 0 {
+		return fmt.Errorf("%w: columns %v have no matching fields in %v",
+			query.Error, strings.Join(unmapped, ", "), value.Type())
+	}
+
+	return rows.Scan(append(destinations, trailing...)...)
+}
@@ -0,0 +1,110 @@
+package executor_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/query"
+	"github.com/amarin/query/executor"
+)
+
+// upperString implements sql.Scanner storing scanned strings in upper case.
+type upperString string
+
+func (value *upperString) Scan(src any) error {
+	*value = upperString(strings.ToUpper(src.(string)))
+	return nil
+}
+
+type audit struct {
+	CreatedAt time.Time
+	UpdatedBy *string
+}
+
+type Owner struct {
+	OwnerID int64
+}
+
+type account struct {
+	audit
+	*Owner
+	Audit     audit       `db:"-"`
+	ID        int64       `db:"account_id"`
+	Code      upperString `db:"code"`
+	Nickname  *string
+	Balance   float64 `db:"total"`
+	UpdatedBy string  // overrides embedded audit field
+}
+
+func TestScanAll_Struct(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	db, fake := openFake(fakeResult{
+		columns: []string{"account_id", "code", "nickname", "total", "created_at", "updated_by", "owner_id"},
+		rows: [][]driver.Value{
+			{int64(1), "ab", "nick", 10.5, created, "admin", int64(7)},
+			{int64(2), "cd", nil, 0.0, created, "robot", int64(8)},
+		},
+	})
+
+	var accounts []account
+
+	builder := query.SelectManyFrom("accounts").Fields(
+		query.Field("id").As("account_id"),
+		query.Field("code"),
+		query.Field("nickname"),
+		query.Sum("amount").As("total"),
+		query.Field("created_at"),
+		query.Field("updated_by"),
+		query.Field("owner_id"),
+	)
+	require.NoError(t, executor.New(db, query.PostgreSQL).FetchCtx(context.Background(), builder, &accounts))
+	require.Len(t, accounts, 2)
+
+	nick := "nick"
+	require.Equal(t, account{
+		audit:     audit{CreatedAt: created},
+		Owner:     &Owner{OwnerID: 7},
+		ID:        1,
+		Code:      "AB",
+		Nickname:  &nick,
+		Balance:   10.5,
+		UpdatedBy: "admin",
+	}, accounts[0])
+	require.Nil(t, accounts[1].Nickname)
+	require.Equal(t, int64(8), accounts[1].OwnerID)
+	require.Contains(t, fake.calls[0].query, "id AS account_id")
+}
+
+func TestScanAll_Unmapped(t *testing.T) {
+	db, _ := openFake(fakeResult{
+		columns: []string{"account_id", "age", "Code", "color"},
+		rows:    [][]driver.Value{{int64(1), int64(2), "x", "red"}},
+	})
+
+	var accounts []account
+
+	err := executor.New(db, query.PostgreSQL).FetchCtx(context.Background(), query.SelectManyFrom("accounts"), &accounts)
+	require.ErrorIs(t, err, query.Error)
+	require.ErrorContains(t, err, "columns age, Code, color have no matching fields in executor_test.account")
+}
+
+func TestScanOne(t *testing.T) {
+	db, _ := openFake(fakeResult{columns: []string{"account_id", "total"}, rows: [][]driver.Value{{int64(3), 1.5}}})
+
+	rows, err := db.Query("SELECT account_id, total FROM accounts")
+	require.NoError(t, err)
+
+	defer func() { require.NoError(t, rows.Close()) }()
+
+	var found *account
+
+	require.NoError(t, executor.ScanOne(rows, &found))
+	require.Equal(t, &account{ID: 3, Balance: 1.5}, found)
+	require.ErrorIs(t, executor.ScanOne(rows, &found), sql.ErrNoRows)
+}
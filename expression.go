@@ -0,0 +1,145 @@
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// expression defines SQL function call or constant used in place of field.
+// FieldDefinition holding expression renders it instead of table and field names.
+type expression struct {
+	function string            // SQL function name, empty for constants
+	args     []FieldDefinition // function arguments
+	distinct bool              // render DISTINCT before arguments, i.e. COUNT(DISTINCT field)
+	literal  any               // constant value, rendered if constant is set
+	constant bool              // render literal value instead of function call
+	now      bool              // render dialect specific current timestamp function
+	window   *Window           // window to compute function over, nil if function is not windowed
+}
+
+// renderFor renders expression using specified Dialect quoting mode.
+func (expr expression) renderFor(dialect Dialect) string {
+	switch {
+	case expr.now && (dialect.kind == kindPostgreSQL || dialect.kind == kindMySQL):
+		return "NOW()"
+	case expr.now:
+		return "CURRENT_TIMESTAMP"
+	case expr.constant:
+		return renderLiteralFor(dialect, expr.literal)
+	}
+
+	args := make([]string, len(expr.args))
+	for idx, arg := range expr.args {
+		args[idx] = arg.renderOperandFor(dialect)
+	}
+
+	argsString := strings.Join(args, ", ")
+	if expr.distinct {
+		argsString = "DISTINCT " + argsString
+	}
+
+	return expr.function + "(" + argsString + ")" + expr.renderWindowFor(dialect)
+}
+
+// expressionField wraps expression into FieldDefinition.
+// Expression rendered with PostgreSQL rules is used as field name to match expressions in ApplyFieldSpec.
+func expressionField(expr expression) FieldDefinition {
+	return FieldDefinition{fieldName: expr.renderFor(PostgreSQL), expr: &expr}
+}
+
+// functionField makes FieldDefinition rendering SQL function call with specified arguments.
+func functionField(function string, args ...FieldDefinition) FieldDefinition {
+	return expressionField(expression{
+		function: function,
+		args:     append(make([]FieldDefinition, 0, len(args)), args...),
+	})
+}
+
+// Sum generates SUM(field) aggregate expression to use in fields list, ordering or conditions.
+// Takes string, FieldName or FieldDefinition. Use FieldDefinition.As to set result alias.
+func Sum[T FieldNameParameter](field T) FieldDefinition {
+	return functionField("SUM", Field(field))
+}
+
+// Avg generates AVG(field) aggregate expression to use in fields list, ordering or conditions.
+// Takes string, FieldName or FieldDefinition. Use FieldDefinition.As to set result alias.
+func Avg[T FieldNameParameter](field T) FieldDefinition {
+	return functionField("AVG", Field(field))
+}
+
+// Min generates MIN(field) aggregate expression to use in fields list, ordering or conditions.
+// Takes string, FieldName or FieldDefinition. Use FieldDefinition.As to set result alias.
+func Min[T FieldNameParameter](field T) FieldDefinition {
+	return functionField("MIN", Field(field))
+}
+
+// Max generates MAX(field) aggregate expression to use in fields list, ordering or conditions.
+// Takes string, FieldName or FieldDefinition. Use FieldDefinition.As to set result alias.
+func Max[T FieldNameParameter](field T) FieldDefinition {
+	return functionField("MAX", Field(field))
+}
+
+// CountOf generates COUNT aggregate expression to use in fields list, ordering or conditions.
+// Renders COUNT(*) if no field specified or COUNT(field) otherwise. Use FieldDefinition.As to set result alias.
+// Named CountOf as Count makes CountBuilder.
+func CountOf(field ...FieldDefinition) FieldDefinition {
+	if len(field) == 0 {
+		return functionField(kwCount.String(), Field("*"))
+	}
+
+	return functionField(kwCount.String(), field[0])
+}
+
+// CountDistinct generates COUNT(DISTINCT field) aggregate expression to use in fields list, ordering or conditions.
+// Takes string, FieldName or FieldDefinition. Use FieldDefinition.As to set result alias.
+func CountDistinct[T FieldNameParameter](field T) FieldDefinition {
+	return expressionField(expression{function: kwCount.String(), args: []FieldDefinition{Field(field)}, distinct: true})
+}
+
+// Coalesce generates COALESCE(field, ...) expression returning the first not NULL argument.
+// Use Literal to define constant fallback value, i.e. Coalesce(Sum("total"), Literal(0)).
+func Coalesce(fields ...FieldDefinition) FieldDefinition {
+	return functionField("COALESCE", fields...)
+}
+
+// Lower generates LOWER(field) expression to use in fields list, ordering or conditions.
+// Takes string, FieldName or FieldDefinition. Use FieldDefinition.As to set result alias.
+func Lower[T FieldNameParameter](field T) FieldDefinition {
+	return functionField("LOWER", Field(field))
+}
+
+// Upper generates UPPER(field) expression to use in fields list, ordering or conditions.
+// Takes string, FieldName or FieldDefinition. Use FieldDefinition.As to set result alias.
+func Upper[T FieldNameParameter](field T) FieldDefinition {
+	return functionField("UPPER", Field(field))
+}
+
+// Now generates current timestamp expression.
+// Rendered as NOW() for PostgreSQL and MySQL and as CURRENT_TIMESTAMP for other dialects.
+func Now() FieldDefinition {
+	return expressionField(expression{now: true})
+}
+
+// Literal generates constant expression to use as function argument, i.e. Coalesce fallback value.
+// Takes nil, bool, integer, float or string value. Strings are enclosed into single quotes
+// escaping quotes inside as well as backslashes for MySQL.
+// Any other value is rendered as string using its default format.
+// Note literal is rendered into query text, use conditions to pass values taken from insecure environment.
+func Literal(value any) FieldDefinition {
+	return expressionField(expression{literal: value, constant: true})
+}
+
+// renderLiteralFor renders constant value as SQL literal using specified Dialect string quoting rules.
+func renderLiteralFor(dialect Dialect, value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return "NULL"
+	case bool:
+		return strings.ToUpper(strconv.FormatBool(typed))
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return fmt.Sprintf("%v", typed)
+	default:
+		return quoteLiteral(dialect, fmt.Sprintf("%v", typed))
+	}
+}
@@ -0,0 +1,75 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/query"
+)
+
+func TestExpressions_RenderSpecFor(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   query.FieldDefinition
+		dialect query.Dialect
+		want    string
+	}{
+		{"sum", query.Sum("total"), query.PostgreSQL, "SUM(total)"},
+		{"sum_table_field", query.Sum("o.total"), query.PostgreSQL, "SUM(o.total)"},
+		{"avg_alias", query.Avg("total").As("avg_total"), query.PostgreSQL, "AVG(total) AS avg_total"},
+		{"min", query.Min(query.FieldName("price")), query.SQLite, "MIN(price)"},
+		{"max", query.Max(query.Field("price")), query.SQLite, "MAX(price)"},
+		{"count_all", query.CountOf(), query.MySQL, "COUNT(*)"},
+		{"count_field", query.CountOf(query.Field("id")), query.MySQL, "COUNT(id)"},
+		{"count_distinct", query.CountDistinct("customer_id"), query.PostgreSQL, "COUNT(DISTINCT customer_id)"},
+		{
+			"coalesce_literal",
+			query.Coalesce(query.Sum("total"), query.Literal(0)).As("amount"),
+			query.PostgreSQL,
+			"COALESCE(SUM(total), 0) AS amount",
+		},
+		{"coalesce_string", query.Coalesce(query.Field("nick"), query.Literal("it's")), query.MySQL, "COALESCE(nick, 'it''s')"},
+		{"literal_backslash_postgres", query.Literal(`a\' OR 1=1`), query.PostgreSQL, `'a\'' OR 1=1'`},
+		{"literal_backslash_mysql", query.Literal(`a\' OR 1=1`), query.MySQL, `'a\\'' OR 1=1'`},
+		{"literal_null", query.Literal(nil), query.PostgreSQL, "NULL"},
+		{"literal_bool", query.Literal(true), query.PostgreSQL, "TRUE"},
+		{"lower", query.Lower("email"), query.PostgreSQL, "LOWER(email)"},
+		{"upper_nested", query.Upper(query.Lower("email")), query.PostgreSQL, "UPPER(LOWER(email))"},
+		{"now_postgres", query.Now(), query.PostgreSQL, "NOW()"},
+		{"now_mysql", query.Now(), query.MySQL, "NOW()"},
+		{"now_sqlite", query.Now(), query.SQLite, "CURRENT_TIMESTAMP"},
+		{"now_sql_server", query.Now().As("ts"), query.SQLServer, "CURRENT_TIMESTAMP AS ts"},
+		{
+			"quoted_arguments",
+			query.Sum("order.total").As("Sum"),
+			query.PostgreSQL.WithQuoting(query.QuoteNeeded),
+			`SUM("order".total) AS "Sum"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.field.RenderSpecFor(tt.dialect))
+		})
+	}
+}
+
+func TestExpressions_Select(t *testing.T) {
+	sql, params, err := query.SelectManyFrom("orders").
+		Fields(
+			query.Field("customer_id"),
+			query.CountOf().As("orders"),
+			query.Coalesce(query.Sum("total"), query.Literal(0)).As("amount"),
+		).
+		Where(query.GreaterOrEqual("created_at", "2024-01-01")).
+		GroupBy(query.Field("customer_id")).
+		Having(query.GreaterThanExpr(query.Sum("total"), 100), query.EqualToExpr(query.Lower("status"), "paid")).
+		OrderBy(query.DESCExpr(query.Sum("total")), query.ASC("customer_id")).
+		Limit(10).
+		BuildQueryAndParams()
+	require.NoError(t, err)
+	require.Equal(t, "SELECT customer_id, COUNT(*) AS orders, COALESCE(SUM(total), 0) AS amount FROM orders "+
+		"WHERE created_at>=$1 GROUP BY customer_id HAVING SUM(total)>$2 AND LOWER(status)=$3 "+
+		"ORDER BY SUM(total) DESC, customer_id ASC LIMIT $4", sql)
+	require.Equal(t, []any{"2024-01-01", 100, "paid", uint(10)}, params)
+}
@@ -9,9 +9,10 @@ import (
 // It contains field name, field table name and field name alias.
 // Used as field name wrapper in conditions and fields lists of queries.
 type FieldDefinition struct {
-	fieldName string // original field name, using string here to reduce type conversions in methods.
-	tableName string // original field table name, do not required until building joins
-	alias     string // retrieve data as this name
+	fieldName string      // original field name, using string here to reduce type conversions in methods.
+	tableName string      // original field table name, do not required until building joins
+	alias     string      // retrieve data as this name
+	expr      *expression // function call or constant to render instead of table and field names
 }
 
 // FieldOrError creates new FieldDefinition.
@@ -135,18 +136,32 @@ func (fieldIdent FieldDefinition) Of(tableName TableName) FieldDefinition {
 
 // RenderSpec returns a field specification to use in SQL queries as a fetch items enumeration.
 func (fieldIdent FieldDefinition) RenderSpec() string {
+	return fieldIdent.RenderSpecFor(PostgreSQL)
+}
+
+// RenderSpecFor returns a field specification to use in SQL queries as a fetch items enumeration.
+// Table name, field name and alias are quoted according to specified Dialect quoting mode.
+func (fieldIdent FieldDefinition) RenderSpecFor(dialect Dialect) string {
+	if fieldIdent.expr != nil {
+		if len(fieldIdent.alias) > 0 {
+			return fieldIdent.expr.renderFor(dialect) + " " + kwAs.String() + " " + dialect.QuoteIdent(fieldIdent.alias)
+		}
+
+		return fieldIdent.expr.renderFor(dialect)
+	}
+
 	items := make([]string, 0, 4)
 	if len(fieldIdent.tableName) > 0 {
-		items = append(items, fieldIdent.tableName+".")
+		items = append(items, dialect.QuoteIdent(fieldIdent.tableName)+".")
 	}
-	items = append(items, fieldIdent.fieldName)
+	items = append(items, dialect.QuoteIdent(fieldIdent.fieldName))
 	switch {
 	case len(fieldIdent.alias) > 0 && fieldIdent.alias != fieldIdent.fieldName:
 		// alias differs field name, render alias
-		items = append(items, " "+kwAs.String()+" ", fieldIdent.alias)
+		items = append(items, " "+kwAs.String()+" ", dialect.QuoteIdent(fieldIdent.alias))
 	case len(fieldIdent.alias) > 0 && fieldIdent.alias == fieldIdent.fieldName && len(fieldIdent.tableName) > 0:
 		// alias matches field name but table is not empty, render alias too
-		items = append(items, " "+kwAs.String()+" ", fieldIdent.alias)
+		items = append(items, " "+kwAs.String()+" ", dialect.QuoteIdent(fieldIdent.alias))
 	}
 
 	return strings.Join(items, "")
@@ -154,20 +169,36 @@ func (fieldIdent FieldDefinition) RenderSpec() string {
 
 // RenderField returns a mustField identification to use in SQL queries in conditional or sorting clauses.
 func (fieldIdent FieldDefinition) RenderField() string {
-	items := make([]string, 0, 4)
-	if len(fieldIdent.tableName) > 0 {
-		items = append(items, fieldIdent.tableName+".")
-	}
-	items = append(items, fieldIdent.fieldName)
+	return fieldIdent.RenderFieldFor(PostgreSQL)
+}
+
+// RenderFieldFor returns a field identification to use in SQL queries in conditional or sorting clauses.
+// Identifiers are quoted according to specified Dialect quoting mode.
+func (fieldIdent FieldDefinition) RenderFieldFor(dialect Dialect) string {
 	switch {
 	case len(fieldIdent.alias) > 0 && fieldIdent.alias != fieldIdent.fieldName:
 		// use alias if defined and differs from original mustField name
-		return fieldIdent.alias
+		return dialect.QuoteIdent(fieldIdent.alias)
+	case fieldIdent.expr != nil:
+		return fieldIdent.expr.renderFor(dialect)
 	case len(fieldIdent.tableName) > 0:
 		// use table.mustField form as mustField name is not empty
-		return fieldIdent.tableName + "." + fieldIdent.fieldName
+		return dialect.QuoteIdent(fieldIdent.tableName) + "." + dialect.QuoteIdent(fieldIdent.fieldName)
 	default:
-		return fieldIdent.fieldName
+		return dialect.QuoteIdent(fieldIdent.fieldName)
+	}
+}
+
+// renderOperandFor returns a field identification or expression to use as condition, grouping or function operand.
+// Unlike RenderFieldFor it never uses alias as aliases are not allowed in such SQL clauses by most databases.
+func (fieldIdent FieldDefinition) renderOperandFor(dialect Dialect) string {
+	switch {
+	case fieldIdent.expr != nil:
+		return fieldIdent.expr.renderFor(dialect)
+	case len(fieldIdent.tableName) > 0:
+		return dialect.QuoteIdent(fieldIdent.tableName) + "." + dialect.QuoteIdent(fieldIdent.fieldName)
+	default:
+		return dialect.QuoteIdent(fieldIdent.fieldName)
 	}
 }
 
@@ -178,6 +209,12 @@ func (fieldIdent FieldDefinition) RenderTableSpec() string {
 	return fieldIdent.tableName + "." + fieldIdent.fieldName
 }
 
+// RenderTableSpecFor returns a field name in form <table_name>.<field_name> as string value.
+// Both table and field names are quoted according to specified Dialect quoting mode.
+func (fieldIdent FieldDefinition) RenderTableSpecFor(dialect Dialect) string {
+	return dialect.QuoteIdent(fieldIdent.tableName) + "." + dialect.QuoteIdent(fieldIdent.fieldName)
+}
+
 // TableFieldName returns a field name in form <table_name>.<field_name> as FieldName value.
 // If value of string type required use RenderTableSpec instead.
 func (fieldIdent FieldDefinition) TableFieldName() FieldName {
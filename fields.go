@@ -46,13 +46,19 @@ func (query Fields) FieldDefinitions() (res []FieldDefinition) {
 
 // FieldList returns spec list string with their possible aliases to build select query.
 func (query Fields) FieldList() string {
+	return query.FieldListFor(PostgreSQL)
+}
+
+// FieldListFor returns spec list string with their possible aliases to build select query.
+// Identifiers are quoted according to specified Dialect quoting mode.
+func (query Fields) FieldListFor(dialect Dialect) string {
 	if len(query.fieldSpecs) == 0 {
 		return "*"
 	}
 
 	fieldSpecs := make([]string, len(query.fieldSpecs))
 	for idx, spec := range query.fieldSpecs {
-		fieldSpecs[idx] = spec.RenderSpec()
+		fieldSpecs[idx] = spec.RenderSpecFor(dialect)
 	}
 
 	return strings.Join(fieldSpecs, ", ")
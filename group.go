@@ -1,5 +1,9 @@
 package query
 
+import (
+	"strings"
+)
+
 // Group implements conditions grouping.
 type Group struct {
 	BaseCondition
@@ -224,11 +228,12 @@ func (conditionsGroup Group) Or(conditions ...Condition) Condition {
 	return conditionsGroup.GroupOR(conditions...)
 }
 
-// Render renders SQL SELECT clause part for current group.
+// RenderDialect renders SQL SELECT clause part for current group using specified Dialect placeholders.
 // Takes existed parameters number (0 means no parameters are defined yet)
-// Note Render renders group without brackets itself if called directly.
+// Note RenderDialect renders group without brackets itself if called directly.
 // Any child conditions groups are enclosed into brackets internally.
-func (conditionsGroup Group) Render(parametersCount int) (sql string) {
+// Implements DialectClauseRenderer.
+func (conditionsGroup Group) RenderDialect(dialect Dialect, parametersCount int) (sql string) {
 	sql = ""
 
 	if len(conditionsGroup.conditions) == 0 {
@@ -241,8 +246,8 @@ func (conditionsGroup Group) Render(parametersCount int) (sql string) {
 			sql += " " + joinToken + " "
 		}
 
-		sql += condition.Render(parametersCount)
-		parametersCount += len(condition.Values())
+		sql += renderConditionFor(condition, dialect, parametersCount)
+		parametersCount += len(conditionValuesFor(condition, dialect))
 	}
 
 	if conditionsGroup.IsNegate() && len(conditionsGroup.conditions) > 1 { // force set brackets when negate
@@ -260,45 +265,32 @@ func (conditionsGroup Group) Render(parametersCount int) (sql string) {
 	return sql
 }
 
+// Render renders SQL SELECT clause part for current group.
+// Takes existed parameters number (0 means no parameters are defined yet)
+// Note Render renders group without brackets itself if called directly.
+// Any child conditions groups are enclosed into brackets internally.
+func (conditionsGroup Group) Render(parametersCount int) (sql string) {
+	return conditionsGroup.RenderDialect(PostgreSQL, parametersCount)
+}
+
 // RenderSQL renders SQL clause or its part.
 // Implementation should render parameters substitutions using standard sql "?"(question) character.
 func (conditionsGroup Group) RenderSQL() (sql string) {
-	sql = ""
-
-	if len(conditionsGroup.conditions) == 0 {
-		return sql
-	}
-
-	for idx, condition := range conditionsGroup.conditions {
-		joinToken := condition.RenderJoin(idx == 0)
-		if len(joinToken) > 0 {
-			sql += " " + joinToken + " "
-		}
-
-		sql += condition.RenderSQL()
-	}
-
-	if conditionsGroup.IsNegate() && len(conditionsGroup.conditions) > 1 { // force set brackets when negate
-		conditionsGroup.withBrackets = true
-	}
-
-	if conditionsGroup.withBrackets { // if render with brackets
-		sql = "(" + sql + ")"
-	}
-
-	if conditionsGroup.IsNegate() {
-		return conditionsGroup.RenderNegate() + " " + sql
-	}
-
-	return sql
+	return conditionsGroup.RenderDialect(rawDialect, 0)
 }
 
 // Values returns a set of values of grouped conditions.
 func (conditionsGroup Group) Values() (values []interface{}) {
-	values = make([]interface{}, 0)
+	return conditionsGroup.ValuesFor(PostgreSQL)
+}
+
+// ValuesFor returns a set of values of grouped conditions ordered as specified Dialect expects.
+// Implements DialectValuesProvider.
+func (conditionsGroup Group) ValuesFor(dialect Dialect) (values []any) {
+	values = make([]any, 0)
 
 	for _, condition := range conditionsGroup.conditions {
-		values = append(values, condition.Values()...)
+		values = append(values, conditionValuesFor(condition, dialect)...)
 	}
 
 	return values
@@ -323,3 +315,55 @@ func (conditionsGroup Group) ApplyFieldTable(table TableName) Condition {
 
 	return conditionsGroup
 }
+
+// renderConditionFor renders condition using specified Dialect placeholders.
+// Conditions not implementing DialectClauseRenderer, i.e. custom ones defined outside the package,
+// are rendered by Render for "$N" placeholders or by RenderSQL having "?" substitutions renumbered otherwise.
+func renderConditionFor(condition Condition, dialect Dialect, parametersCount int) string {
+	if renderer, ok := condition.(DialectClauseRenderer); ok {
+		return renderer.RenderDialect(dialect, parametersCount)
+	}
+
+	if dialect.placeholders == DollarPlaceholders {
+		return condition.Render(parametersCount)
+	}
+
+	return numberPlaceholders(dialect, condition.RenderSQL(), parametersCount)
+}
+
+// conditionValuesFor returns condition values ordered as specified Dialect expects
+// if condition implements DialectValuesProvider or its Values otherwise.
+func conditionValuesFor(condition Condition, dialect Dialect) []any {
+	if provider, ok := condition.(DialectValuesProvider); ok {
+		return provider.ValuesFor(dialect)
+	}
+
+	return condition.Values()
+}
+
+// numberPlaceholders replaces "?" substitutions of sql with Dialect placeholders numbered after parametersCount.
+// Quoted strings and identifiers are kept untouched.
+func numberPlaceholders(dialect Dialect, sql string, parametersCount int) string {
+	if dialect.placeholders == QuestionPlaceholders {
+		return sql
+	}
+
+	builder := strings.Builder{}
+	runes := []rune(sql)
+
+	for pos := 0; pos < len(runes); pos++ {
+		switch char := runes[pos]; {
+		case char == '\'' || char == '"' || char == '`':
+			end := quotedEnd(runes, pos)
+			builder.WriteString(string(runes[pos:end]))
+			pos = end - 1
+		case char == '?':
+			parametersCount++
+			builder.WriteString(dialect.Placeholder(parametersCount))
+		default:
+			builder.WriteRune(char)
+		}
+	}
+
+	return builder.String()
+}
@@ -1,16 +1,28 @@
 package query
 
 import (
+	"errors"
 	"fmt"
-	"strconv"
+	"sort"
 	"strings"
 )
 
+// Statement holds single SQL query string together with parameters to fill its placeholders.
+type Statement struct {
+	SQL    string // SQL query string
+	Params []any  // parameters to substitute
+}
+
 // InsertBuilder helps to build SQL INSERT queries.
 type InsertBuilder struct {
 	BaseBuilder
-	tableName TableName
-	setValues []FieldValue
+	tableName  TableName
+	setValues  []FieldValue
+	rows       []ValuesMap
+	source     SubQuery    // rows source of INSERT ... SELECT query
+	columns    []FieldName // columns to insert selected rows into
+	onConflict onConflictClause
+	returning  returningClause
 }
 
 // RenderFrom returns string representation of table name or tables join with possible tables aliases.
@@ -30,38 +42,300 @@ func (inserter InsertBuilder) Values(values ...FieldValue) InsertBuilder {
 	return inserter
 }
 
+// Rows generates new InsertBuilder having additional rows to insert using multi-row SQL INSERT query.
+// Can be called multiple times to add as many rows as required.
+// Every row should define the same fields set. If any field values set with Values, they used as the first row.
+// Columns order is taken from the first row, using sorted field names if the first row is ValuesMap.
+// Build errors refer rows by number starting from 1 including the row of field values set with Values.
+func (inserter InsertBuilder) Rows(rows ...ValuesMap) InsertBuilder {
+	inserter.rows = append(append(make([]ValuesMap, 0, len(inserter.rows)+len(rows)), inserter.rows...), rows...)
+	return inserter
+}
+
+// Select generates new InsertBuilder taking rows to insert from SQL SELECT query, i.e. INSERT INTO ... SELECT.
+// Takes BaseSelectBuilder, SelectManyBuilder or SelectSingleBuilder as rows source and columns to insert into.
+// Columns count should match selected fields count. If no columns specified, column list is not rendered
+// and selected fields are inserted in table columns order.
+// Note query build fails if any field values set with Values or Rows.
+func (inserter InsertBuilder) Select(source SubQuery, columns ...FieldName) InsertBuilder {
+	inserter.source = source
+	inserter.columns = append(make([]FieldName, 0, len(columns)), columns...)
+
+	return inserter
+}
+
+// OnConflict generates intermediate IncompleteInsertConflict instance to define insert conflict resolution.
+// Takes fields of unique constraint to detect conflicts on. Fields are ignored by MySQL using any unique key.
+// Call IncompleteInsertConflict.DoNothing or IncompleteInsertConflict.DoUpdate to return updated InsertBuilder.
+// Note query build fails for dialects not supporting conflicts resolution.
+func (inserter InsertBuilder) OnConflict(fields ...FieldDefinition) IncompleteInsertConflict {
+	inserter.onConflict = onConflictClause{
+		action: conflictNotSet,
+		target: append(make([]FieldDefinition, 0, len(fields)), fields...),
+		where:  NewGroup(LogicalAND),
+	}
+
+	return incompleteInsertConflict{InsertBuilder: inserter}
+}
+
+// DoUpdateWhere generates new InsertBuilder having conditions to update conflicting rows only when conditions met.
+// Conditions are rendered as WHERE clause of ON CONFLICT DO UPDATE action.
+// Note query build fails if conditions set for MySQL dialect.
+func (inserter InsertBuilder) DoUpdateWhere(conditions ...Condition) InsertBuilder {
+	inserter.onConflict.where = inserter.onConflict.where.GroupAND(conditions...)
+	return inserter
+}
+
+// Returning generates new InsertBuilder requesting inserted rows fields using SQL RETURNING clause.
+// Fields are rendered with their aliases. If no fields specified, all fields are returned.
+// Note query build fails for dialects not supporting RETURNING clause.
+func (inserter InsertBuilder) Returning(fields ...FieldDefinition) InsertBuilder {
+	inserter.returning = inserter.returning.Fields(fields...)
+	return inserter
+}
+
+// String returns debug representation of InsertBuilder query having parameters inlined, see DebugSQL.
+// Implements fmt.Stringer.
+func (inserter InsertBuilder) String() string {
+	return DebugSQL(inserter)
+}
+
 // BuildQueryAndParams generates SQL INSERT query based on the set values.
 // Returns SQL INSERT query string, parameters to fill placeholders in driver.
 // If any errors occurs returns that error.
+// Uses PostgreSQL "$N" placeholders, use BuildQueryAndParamsFor to generate query for another Dialect.
 func (inserter InsertBuilder) BuildQueryAndParams() (sql string, params []interface{}, err error) {
-	params = make([]interface{}, 0)
+	return inserter.BuildQueryAndParamsFor(PostgreSQL)
+}
 
-	// disallow some cases
-	switch {
-	case len(inserter.setValues) == 0:
-		return "", params, fmt.Errorf("%w: no fields to insert", Error)
-	case len(inserter.tableName) == 0:
-		return "", params, fmt.Errorf("%w: table name empty", Error)
+// BuildQueryAndParamsFor generates SQL INSERT query based on the set values using specified Dialect.
+// Returns SQL INSERT query string, parameters to fill placeholders in driver.
+// If any errors occurs returns that error.
+// Returns error if rows to insert require more parameters than Dialect.MaxParameters allows in single query,
+// use BuildStatementsFor to split insert into several statements in such cases.
+func (inserter InsertBuilder) BuildQueryAndParamsFor(dialect Dialect) (sql string, params []interface{}, err error) {
+	var statements []Statement
+
+	if statements, err = inserter.BuildStatementsFor(dialect); err != nil {
+		return "", make([]any, 0), err
+	}
+
+	if len(statements) > 1 {
+		return "", make([]any, 0), fmt.Errorf(
+			"%w: rows to insert exceed %v parameters limit %d, use BuildStatementsFor",
+			Error, dialect, dialect.MaxParameters())
 	}
 
-	columns := make([]string, 0)
-	valuePlaceholders := make([]string, 0)
+	return statements[0].SQL, statements[0].Params, nil
+}
+
+// BuildStatements generates a list of SQL INSERT statements based on the set values and rows.
+// Uses PostgreSQL "$N" placeholders, use BuildStatementsFor to generate statements for another Dialect.
+func (inserter InsertBuilder) BuildStatements() (statements []Statement, err error) {
+	return inserter.BuildStatementsFor(PostgreSQL)
+}
+
+// BuildStatementsFor generates a list of SQL INSERT statements based on the set values and rows.
+// Rows are split into several statements to keep parameters count of each one under Dialect.MaxParameters.
+// Returns error if no values to insert set, rows fields sets differ or table name is empty.
+func (inserter InsertBuilder) BuildStatementsFor(dialect Dialect) (statements []Statement, err error) {
+	var (
+		returning string
+		columns   []string
+		rows      [][]FieldValue
+	)
+
+	if len(inserter.tableName) == 0 {
+		return nil, fmt.Errorf("%w: table name empty", Error)
+	}
 
-	for _, fieldValue := range inserter.setValues {
-		params = append(params, fieldValue.Values()...)
+	if err = inserter.onConflict.validate(dialect); err != nil {
+		return nil, err
+	}
 
-		columns = append(columns, fieldValue.fieldName)
-		valuePlaceholders = append(valuePlaceholders, "$"+strconv.Itoa(len(params)))
+	if returning, err = inserter.returning.RenderFor(dialect); err != nil {
+		return nil, err
 	}
 
-	sql = strings.Join([]string{
+	if inserter.source != nil {
+		return inserter.buildSelectStatement(dialect, returning)
+	}
+
+	if columns, rows, err = inserter.rowsToInsert(); err != nil {
+		return nil, err
+	}
+
+	quotedColumns := make([]string, len(columns))
+	for idx, column := range columns {
+		quotedColumns[idx] = dialect.QuoteIdent(column)
+	}
+
+	prefix := strings.Join([]string{
 		kwInsert.String(), kwInto.String(),
-		inserter.tableName.String() + "(" + strings.Join(columns, ", ") + ")",
+		inserter.tableName.RenderFromFor(dialect) + "(" + strings.Join(quotedColumns, ", ") + ")",
 		kwValues.String(),
-		"(" + strings.Join(valuePlaceholders, ", ") + ")",
 	}, " ")
+	statements = make([]Statement, 0, 1)
+	tuples := make([]string, 0, len(rows))
+	params := make([]any, 0)
+	conflictParams := inserter.onConflict.valuesFor(dialect)
+	maxRowsParams := dialect.MaxParameters() - len(conflictParams)
+
+	flush := func() {
+		sql := prefix + " " + strings.Join(tuples, ", ")
+		if conflict := inserter.onConflict.RenderDialect(dialect, len(params), columns); len(conflict) > 0 {
+			sql += " " + conflict
+			params = append(params, conflictParams...)
+		}
+
+		if len(returning) > 0 {
+			sql += " " + returning
+		}
+
+		statements = append(statements, Statement{SQL: sql, Params: params})
+		tuples = make([]string, 0, len(rows))
+		params = make([]any, 0)
+	}
+
+	for idx, row := range rows {
+		rowParams := make([]any, 0, len(row))
+		for _, fieldValue := range row {
+			rowParams = append(rowParams, fieldValue.Values()...)
+		}
+
+		if len(rowParams) > maxRowsParams {
+			return nil, fmt.Errorf("%w: row %d requires %d parameters exceeding %v limit %d",
+				Error, idx+1, len(rowParams)+len(conflictParams), dialect, dialect.MaxParameters())
+		}
+
+		if len(params)+len(rowParams) > maxRowsParams {
+			flush()
+		}
+
+		placeholders := make([]string, 0, len(row))
+		for _, fieldValue := range row {
+			params = append(params, fieldValue.Values()...)
+			placeholders = append(placeholders, dialect.Placeholder(len(params)))
+		}
+
+		tuples = append(tuples, "("+strings.Join(placeholders, ", ")+")")
+	}
+
+	flush()
+
+	return statements, nil
+}
+
+// buildSelectStatement generates SQL INSERT ... SELECT statement taking rows from source query.
+// Takes already rendered RETURNING clause to append.
+// Returns error if columns count differs from selected fields count or parameters limit exceeded.
+func (inserter InsertBuilder) buildSelectStatement(dialect Dialect, returning string) ([]Statement, error) {
+	if len(inserter.setValues) > 0 || len(inserter.rows) > 0 {
+		return nil, fmt.Errorf("%w: insert values could not be used together with select", Error)
+	}
+
+	selectedCount := len(inserter.source.FieldDefinitions())
+	if len(inserter.columns) > 0 && len(inserter.columns) != selectedCount {
+		return nil, fmt.Errorf("%w: %d columns to insert while %d fields selected",
+			Error, len(inserter.columns), selectedCount)
+	}
+
+	columns := make([]string, len(inserter.columns))
+	quotedColumns := make([]string, len(inserter.columns))
+
+	for idx, column := range inserter.columns {
+		columns[idx] = string(column)
+		quotedColumns[idx] = dialect.QuoteIdent(string(column))
+	}
+
+	if len(columns) == 0 && inserter.onConflict.action == conflictDoNothing && dialect.kind == kindMySQL {
+		return nil, fmt.Errorf("%w: %v dialect requires columns to skip conflicting rows", Error, dialect)
+	}
+
+	into := inserter.tableName.RenderFromFor(dialect)
+	if len(quotedColumns) > 0 {
+		into += "(" + strings.Join(quotedColumns, ", ") + ")"
+	}
+
+	sql := strings.Join([]string{
+		kwInsert.String(), kwInto.String(), into, inserter.source.RenderDialect(dialect, 0),
+	}, " ")
+	params := append(make([]any, 0), inserter.source.ValuesFor(dialect)...)
+
+	if conflict := inserter.onConflict.RenderDialect(dialect, len(params), columns); len(conflict) > 0 {
+		sql += " " + conflict
+		params = append(params, inserter.onConflict.valuesFor(dialect)...)
+	}
+
+	if len(returning) > 0 {
+		sql += " " + returning
+	}
+
+	if len(params) > dialect.MaxParameters() {
+		return nil, fmt.Errorf("%w: query requires %d parameters exceeding %v limit %d",
+			Error, len(params), dialect, dialect.MaxParameters())
+	}
+
+	return []Statement{{SQL: sql, Params: params}}, nil
+}
+
+// rowsToInsert returns column names to insert and every row field values ordered by columns.
+// Values set with Values are used as the first row, ValuesMap rows are following it.
+// Returns error if there is no fields to insert or rows fields sets differ.
+func (inserter InsertBuilder) rowsToInsert() (columns []string, rows [][]FieldValue, err error) {
+	rows = make([][]FieldValue, 0, 1+len(inserter.rows))
+
+	if len(inserter.setValues) > 0 {
+		rows = append(rows, inserter.setValues)
+	}
+
+	for _, valuesMap := range inserter.rows {
+		var fieldValues []FieldValue
+
+		if fieldValues, err = valuesMap.FieldValues(); err != nil {
+			return nil, nil, errors.Join(fmt.Errorf("%w: row %d", Error, len(rows)+1), err)
+		}
+
+		if len(rows) == 0 { // ValuesMap keys have no order, use sorted names for the first row
+			sort.Slice(fieldValues, func(i, j int) bool { return fieldValues[i].fieldName < fieldValues[j].fieldName })
+		}
+
+		rows = append(rows, fieldValues)
+	}
+
+	if len(rows) == 0 || len(rows[0]) == 0 {
+		return nil, nil, fmt.Errorf("%w: no fields to insert", Error)
+	}
+
+	columns = make([]string, len(rows[0]))
+	for idx, fieldValue := range rows[0] {
+		columns[idx] = fieldValue.fieldName
+	}
+
+	for rowIdx := 1; rowIdx < len(rows); rowIdx++ {
+		byName := make(map[string]FieldValue, len(rows[rowIdx]))
+		for _, fieldValue := range rows[rowIdx] {
+			byName[fieldValue.fieldName] = fieldValue
+		}
+
+		if len(byName) != len(columns) || len(rows[rowIdx]) != len(columns) {
+			return nil, nil, fmt.Errorf("%w: row %d fields set differs from columns %v", Error, rowIdx+1, columns)
+		}
+
+		ordered := make([]FieldValue, len(columns))
+		for idx, column := range columns {
+			fieldValue, found := byName[column]
+			if !found {
+				return nil, nil, fmt.Errorf("%w: row %d has no field %v", Error, rowIdx+1, column)
+			}
+
+			ordered[idx] = fieldValue
+		}
+
+		rows[rowIdx] = ordered
+	}
 
-	return sql, params, nil
+	return columns, rows, nil
 }
 
 // TableName returns table name to insert data into.
@@ -77,3 +77,261 @@ func TestInsertBuilder_BuildQueryAndParams(t *testing.T) {
 		})
 	}
 }
+
+func TestInsertBuilder_Rows(t *testing.T) {
+	tests := []struct {
+		name       string
+		builder    query.InsertBuilder
+		dialect    query.Dialect
+		wantSql    string
+		wantParams []interface{}
+		wantErr    bool
+	}{
+		{
+			"ok_rows_sorted_columns",
+			query.InsertInto("t1").Rows(
+				query.ValuesMap{"b": 1, "a": "x"},
+				query.ValuesMap{"a": "y", "b": 2},
+			),
+			query.PostgreSQL,
+			"INSERT INTO t1(a, b) VALUES ($1, $2), ($3, $4)",
+			[]interface{}{"x", 1, "y", 2},
+			false,
+		},
+		{
+			"ok_values_define_columns_order",
+			query.InsertInto("t1").
+				Values(query.FieldName("b").Value(1), query.FieldName("a").Value("x")).
+				Rows(query.ValuesMap{"a": "y", "b": 2}),
+			query.SQLite,
+			"INSERT INTO t1(b, a) VALUES (?, ?), (?, ?)",
+			[]interface{}{1, "x", 2, "y"},
+			false,
+		},
+		{
+			"ok_rows_returning",
+			query.InsertInto("t1").Rows(query.ValuesMap{"a": 1}, query.ValuesMap{"a": 2}).Returning(query.Field("id")),
+			query.PostgreSQL,
+			"INSERT INTO t1(a) VALUES ($1), ($2) RETURNING id",
+			[]interface{}{1, 2},
+			false,
+		},
+		{
+			"nok_row_missing_field",
+			query.InsertInto("t1").Rows(query.ValuesMap{"a": 1, "b": 2}, query.ValuesMap{"a": 3}),
+			query.PostgreSQL,
+			"",
+			nil,
+			true,
+		},
+		{
+			"nok_row_another_field",
+			query.InsertInto("t1").Rows(query.ValuesMap{"a": 1, "b": 2}, query.ValuesMap{"a": 3, "c": 4}),
+			query.PostgreSQL,
+			"",
+			nil,
+			true,
+		},
+		{
+			"nok_row_invalid_field_name",
+			query.InsertInto("t1").Rows(query.ValuesMap{"a b": 1}),
+			query.PostgreSQL,
+			"",
+			nil,
+			true,
+		},
+		{
+			"nok_empty_row",
+			query.InsertInto("t1").Rows(query.ValuesMap{}),
+			query.PostgreSQL,
+			"",
+			nil,
+			true,
+		},
+		{
+			"nok_exceeds_parameters_limit",
+			query.InsertInto("t1").Rows(query.ValuesMap{"a": 1, "b": 2}, query.ValuesMap{"a": 3, "b": 4}),
+			query.SQLite.WithMaxParameters(3),
+			"",
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSQL, gotParams, err := tt.builder.BuildQueryAndParamsFor(tt.dialect)
+			require.Equalf(t, tt.wantErr, err != nil, "want error %v, got %v", tt.wantErr, err)
+			if err != nil {
+				return
+			}
+
+			require.Equal(t, tt.wantSql, gotSQL)
+			require.Equal(t, tt.wantParams, gotParams)
+		})
+	}
+}
+
+func TestInsertBuilder_RowsErrorNumber(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder query.InsertBuilder
+		wantErr string
+	}{
+		{
+			"second_row_invalid_field_name",
+			query.InsertInto("t1").Rows(query.ValuesMap{"a": 1}, query.ValuesMap{"a b": 2}),
+			"row 2",
+		},
+		{
+			"second_row_after_values_invalid_field_name",
+			query.InsertInto("t1").Values(query.FieldName("a").Value(1)).Rows(query.ValuesMap{"a b": 2}),
+			"row 2",
+		},
+		{
+			"third_row_invalid_field_name",
+			query.InsertInto("t1").
+				Values(query.FieldName("a").Value(1)).
+				Rows(query.ValuesMap{"a": 2}, query.ValuesMap{"a b": 3}),
+			"row 3",
+		},
+		{
+			"second_row_missing_field",
+			query.InsertInto("t1").Rows(query.ValuesMap{"a": 1, "b": 2}, query.ValuesMap{"a": 3}),
+			"row 2 fields set differs",
+		},
+		{
+			"third_row_another_field",
+			query.InsertInto("t1").
+				Values(query.FieldName("a").Value(1), query.FieldName("b").Value(2)).
+				Rows(query.ValuesMap{"a": 3, "b": 4}, query.ValuesMap{"a": 5, "c": 6}),
+			"row 3 has no field b",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := tt.builder.BuildQueryAndParamsFor(query.PostgreSQL)
+			require.ErrorIs(t, err, query.Error)
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestInsertBuilder_BuildStatementsFor(t *testing.T) {
+	builder := query.InsertInto("t1").Rows(
+		query.ValuesMap{"a": 1, "b": 2},
+		query.ValuesMap{"a": 3, "b": 4},
+		query.ValuesMap{"a": 5, "b": 6},
+	)
+
+	t.Run("single_statement_under_limit", func(t *testing.T) {
+		statements, err := builder.BuildStatements()
+		require.NoError(t, err)
+		require.Equal(t, []query.Statement{
+			{SQL: "INSERT INTO t1(a, b) VALUES ($1, $2), ($3, $4), ($5, $6)", Params: []any{1, 2, 3, 4, 5, 6}},
+		}, statements)
+	})
+
+	t.Run("split_over_limit", func(t *testing.T) {
+		statements, err := builder.BuildStatementsFor(query.PostgreSQL.WithMaxParameters(5))
+		require.NoError(t, err)
+		require.Equal(t, []query.Statement{
+			{SQL: "INSERT INTO t1(a, b) VALUES ($1, $2), ($3, $4)", Params: []any{1, 2, 3, 4}},
+			{SQL: "INSERT INTO t1(a, b) VALUES ($1, $2)", Params: []any{5, 6}},
+		}, statements)
+	})
+
+	t.Run("row_over_limit", func(t *testing.T) {
+		_, err := builder.BuildStatementsFor(query.PostgreSQL.WithMaxParameters(1))
+		require.ErrorIs(t, err, query.Error)
+	})
+}
+
+func TestInsertBuilder_Select(t *testing.T) {
+	live := query.SelectFrom("orders").
+		Fields(query.Field("id"), query.Field("total")).
+		Where(query.EqualTo("status", "closed"))
+
+	tests := []struct {
+		name       string
+		builder    query.InsertBuilder
+		dialect    query.Dialect
+		wantSQL    string
+		wantParams []any
+		wantErr    bool
+	}{
+		{
+			"base_select",
+			query.InsertInto("archive").Select(live, "id", "total"),
+			query.PostgreSQL,
+			"INSERT INTO archive(id, total) SELECT id, total FROM orders WHERE status=$1",
+			[]any{"closed"},
+			false,
+		},
+		{
+			"no_columns",
+			query.InsertInto("archive").Select(live),
+			query.SQLite,
+			"INSERT INTO archive SELECT id, total FROM orders WHERE status=?",
+			[]any{"closed"},
+			false,
+		},
+		{
+			"select_many_with_conflict",
+			query.InsertInto("archive").
+				Select(live.OrderBy(query.ASC("id")).Limit(100), "id", "total").
+				OnConflict(query.Field("id")).DoUpdate(query.FieldName("archived").Value(true)),
+			query.PostgreSQL,
+			"INSERT INTO archive(id, total) SELECT id, total FROM orders WHERE status=$1 ORDER BY id ASC LIMIT $2 " +
+				"ON CONFLICT (id) DO UPDATE SET archived=$3",
+			[]any{"closed", uint(100), true},
+			false,
+		},
+		{
+			"select_many_mysql",
+			query.InsertInto("archive").Select(live.Offset(10).Limit(100), "id", "total"),
+			query.MySQL,
+			"INSERT INTO archive(id, total) SELECT id, total FROM orders WHERE status=? LIMIT ? OFFSET ?",
+			[]any{"closed", uint(100), uint(10)},
+			false,
+		},
+		{
+			"select_single_sql_server",
+			query.InsertInto("archive").Select(live.Single(), "id", "total"),
+			query.SQLServer,
+			"INSERT INTO archive(id, total) SELECT id, total FROM orders WHERE status=@p1 " +
+				"ORDER BY (SELECT NULL) OFFSET 0 ROWS FETCH NEXT 1 ROWS ONLY",
+			[]any{"closed"},
+			false,
+		},
+		{
+			"columns_count_mismatch",
+			query.InsertInto("archive").Select(live, "id"),
+			query.PostgreSQL,
+			"", nil, true,
+		},
+		{
+			"all_fields_with_columns",
+			query.InsertInto("archive").Select(query.SelectFrom("orders"), "id"),
+			query.PostgreSQL,
+			"", nil, true,
+		},
+		{
+			"values_and_select",
+			query.InsertInto("archive").Values(query.FieldName("id").Value(1)).Select(live, "id", "total"),
+			query.PostgreSQL,
+			"", nil, true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSQL, gotParams, err := tt.builder.BuildQueryAndParamsFor(tt.dialect)
+			require.Equalf(t, tt.wantErr, err != nil, "want error %v, got %v", tt.wantErr, err)
+			if err != nil {
+				require.ErrorIs(t, err, query.Error)
+				return
+			}
+			require.Equal(t, tt.wantSQL, gotSQL)
+			require.Equal(t, tt.wantParams, gotParams)
+		})
+	}
+}
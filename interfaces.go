@@ -49,6 +49,20 @@ type IncompleteSelectSingleJoiner interface {
 	On(leftField FieldDefinition, rightField FieldDefinition) (updated SelectSingleBuilder)
 }
 
+// IncompleteInsertConflict items is generated by InsertBuilder.OnConflict.
+// It provides methods DoNothing and DoUpdate required to finalize conflict resolution clause
+// and return to InsertBuilder instance.
+type IncompleteInsertConflict interface {
+	// DoNothing finalises conflict resolution definition to skip conflicting rows.
+	// Returns updated InsertBuilder instance.
+	DoNothing() (updated InsertBuilder)
+
+	// DoUpdate finalises conflict resolution definition to update conflicting rows with specified values.
+	// Use Excluded to set field to the value proposed for insertion.
+	// Returns updated InsertBuilder instance.
+	DoUpdate(values ...FieldValue) (updated InsertBuilder)
+}
+
 // Fetcher requires implementation could fetch records from underline database using prepared query SelectManyBuilder.
 type Fetcher interface {
 	FetchCtx(ctx context.Context, queryParams SelectManyBuilder, target interface{}) (err error)
@@ -60,6 +74,9 @@ type Getter interface {
 }
 
 // Updater requires implementation provides single record update method using prepared UpdateBuilder.
+// Implementations relying on affected rows count to detect missing record depend on database driver:
+// MySQL reports changed rows only unless CLIENT_FOUND_ROWS flag is set, so update keeping values unchanged
+// looks like missing record.
 type Updater interface {
 	UpdateOneCtx(ctx context.Context, updateParams UpdateBuilder) (err error)
 }
@@ -96,6 +113,38 @@ type CountingClauseRenderer interface {
 	Render(parametersCount int) (sql string)
 }
 
+// DialectClauseRenderer requires implementations could render its SQL clause part for specified Dialect.
+type DialectClauseRenderer interface {
+	// RenderDialect renders SQL clause or its part with respect of parameters count added previously.
+	// Takes Dialect to render for and existed parameters count (0 means no parameters are defined yet).
+	// Implementation should render parameters substitutions starting from number = paramNum+1
+	// using Dialect.Placeholder to render every single substitution.
+	// Values result should contain the same values count as substitutions used in clause.
+	// If implementation is condition and condition is negated, implementation SHOULD write negate prefix itself.
+	RenderDialect(dialect Dialect, parametersCount int) (sql string)
+}
+
+// QueryBuilder requires implementations could build complete SQL query with parameters for specified Dialect.
+type QueryBuilder interface {
+	// BuildQueryAndParams generates SQL query string and parameters set using PostgreSQL "$N" placeholders.
+	BuildQueryAndParams() (sql string, params []any, err error)
+
+	// BuildQueryAndParamsFor generates SQL query string and parameters set using specified Dialect.
+	BuildQueryAndParamsFor(dialect Dialect) (sql string, params []any, err error)
+}
+
+// SubQuery requires implementations could be rendered as a part of another SQL query.
+// Implemented by BaseSelectBuilder, SelectManyBuilder, SelectSingleBuilder, WithBuilder and CompoundSelectBuilder.
+type SubQuery interface {
+	DialectClauseRenderer
+
+	// ValuesFor returns a set of parameters to substitute into query rendered with specified Dialect.
+	ValuesFor(dialect Dialect) []any
+
+	// FieldDefinitions returns a list of fields query selects. Empty list means all fields are selected.
+	FieldDefinitions() []FieldDefinition
+}
+
 // ValuesProvider requires clause renderer implementations should provide substitution values slice.
 type ValuesProvider interface {
 	// Values returns a set of parameters to substitute when SQL query is fully constructed and passed to execution.
@@ -104,6 +153,14 @@ type ValuesProvider interface {
 	Values() []any
 }
 
+// DialectValuesProvider requires implementations could provide parameters ordered as query rendered
+// for specified Dialect expects, i.e. conditions having subqueries with pagination.
+// Conditions implementing it are asked for Dialect specific values, ValuesProvider is used otherwise.
+type DialectValuesProvider interface {
+	// ValuesFor returns a set of parameters to substitute into clause rendered with specified Dialect.
+	ValuesFor(dialect Dialect) []any
+}
+
 // RawClauseRenderer requires implementations could render its SQL clause part using default parameters substitution.
 type RawClauseRenderer interface {
 	// RenderSQL renders SQL clause or its part.
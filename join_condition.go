@@ -45,7 +45,12 @@ func (joinCondition JoinCondition) FullJoin() TableJoiner {
 
 // Render renders SQL JOIN condition clause.
 func (joinCondition JoinCondition) Render() string {
-	return joinCondition.leftField.RenderTableSpec() + "=" + joinCondition.rightField.RenderTableSpec()
+	return joinCondition.RenderFor(PostgreSQL)
+}
+
+// RenderFor renders SQL JOIN condition clause quoting fields according to specified Dialect quoting mode.
+func (joinCondition JoinCondition) RenderFor(dialect Dialect) string {
+	return joinCondition.leftField.RenderTableSpecFor(dialect) + "=" + joinCondition.rightField.RenderTableSpecFor(dialect)
 }
 
 // JoinFields makes JoinCondition parameters to render SQL JOIN condition clause.
@@ -36,11 +36,16 @@ func (tableJoiner TableJoiner) By(leftTableField FieldDefinition, rightTableFiel
 
 // RenderFrom returns SQL FROM clause filled with required tables.
 func (tableJoiner TableJoiner) RenderFrom() string {
+	return tableJoiner.RenderFromFor(PostgreSQL)
+}
+
+// RenderFromFor returns SQL FROM clause filled with required tables quoted according to specified Dialect.
+func (tableJoiner TableJoiner) RenderFromFor(dialect Dialect) string {
 	return strings.Join([]string{
 		tableJoiner.joinType.String(),
-		tableJoiner.rightTable.RenderFrom(),
+		tableJoiner.rightTable.RenderFromFor(dialect),
 		kwOn.String(),
-		tableJoiner.joinCondition.Render(),
+		tableJoiner.joinCondition.RenderFor(dialect),
 	}, " ")
 }
 
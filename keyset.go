@@ -0,0 +1,395 @@
+package query
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Cursor holds ordering fields values of the row to continue keyset pagination from,
+// i.e. the last row of current page to get the next one using SelectManyBuilder.After.
+// Values are listed in the same order as ordering fields of SelectManyBuilder.
+type Cursor []any
+
+// keyset implements condition to match rows following or preceding cursor row in specified ordering.
+// It is rendered as expanded row values comparison (a>$1 OR (a=$2 AND b>$3)) supporting mixed
+// ordering directions and renders the same parameters set for any Dialect.
+type keyset struct {
+	BaseCondition
+	order  []FieldSorting
+	cursor Cursor
+	before bool // match rows preceding cursor row
+}
+
+// FieldName returns empty string as condition is applied to several fields. Implements Condition.
+func (impl keyset) FieldName() FieldName {
+	return ""
+}
+
+// ApplyFieldTable returns a copy of condition having all ordering fields table name updated.
+// Implements Condition.
+func (impl keyset) ApplyFieldTable(table TableName) Condition {
+	order := make([]FieldSorting, len(impl.order))
+	for idx, sorting := range impl.order {
+		order[idx] = sorting.applyFieldTable(table)
+	}
+
+	impl.order = order
+
+	return impl
+}
+
+// ApplyFieldSpec returns a copy of condition having ordering fields definitions updated if field name matches.
+// Implements Condition.
+func (impl keyset) ApplyFieldSpec(spec FieldDefinition) Condition {
+	order := make([]FieldSorting, len(impl.order))
+	for idx, sorting := range impl.order {
+		order[idx] = sorting.ApplyFieldSpec(spec)
+	}
+
+	impl.order = order
+
+	return impl
+}
+
+// Join returns a copy of Group having JoinType set to specified value.
+func (impl keyset) Join(newJoinType JoinType) Condition {
+	impl.BaseCondition = impl.BaseCondition.Join(newJoinType)
+	return impl
+}
+
+// Negate returns a copy of BaseCondition having IsNegate set to specified value.
+func (impl keyset) Negate(newNegateIndicator bool) Condition {
+	impl.BaseCondition = impl.BaseCondition.Negate(newNegateIndicator)
+	return impl
+}
+
+// operator returns comparison operator of ordering field matching rows after or before cursor.
+func (impl keyset) operator(sorting FieldSorting) string {
+	if (sorting.direction == Descending) != impl.before {
+		return "<"
+	}
+
+	return ">"
+}
+
+// RenderDialect renders expanded row values comparison enclosed into brackets.
+// Renders parameters substitutions after paramNum using specified Dialect placeholders.
+// Implements DialectClauseRenderer.
+func (impl keyset) RenderDialect(dialect Dialect, paramNum int) string {
+	alternatives := make([]string, len(impl.order))
+
+	for idx, sorting := range impl.order {
+		tokens := make([]string, 0, idx+1)
+
+		for _, equal := range impl.order[:idx] {
+			paramNum++
+			tokens = append(tokens, equal.renderOperandFor(dialect)+"="+dialect.Placeholder(paramNum))
+		}
+
+		paramNum++
+		tokens = append(tokens, sorting.renderOperandFor(dialect)+impl.operator(sorting)+dialect.Placeholder(paramNum))
+
+		alternatives[idx] = strings.Join(tokens, " AND ")
+		if idx > 0 {
+			alternatives[idx] = "(" + alternatives[idx] + ")"
+		}
+	}
+
+	sql := "(" + strings.Join(alternatives, " OR ") + ")"
+	if impl.IsNegate() {
+		return impl.RenderNegate() + " " + sql
+	}
+
+	return sql
+}
+
+// Render renders expanded row values comparison using "$<number>" substitutions.
+func (impl keyset) Render(paramNum int) string {
+	return impl.RenderDialect(PostgreSQL, paramNum)
+}
+
+// RenderSQL renders SQL clause or its part.
+// Implementation should render parameters substitutions using standard sql "?"(question) character.
+func (impl keyset) RenderSQL() (sql string) {
+	return impl.RenderDialect(rawDialect, 0)
+}
+
+// Values returns cursor values in rendered substitutions order, preceding fields values are repeated
+// in every comparison alternative. Implements ValuesProvider.
+func (impl keyset) Values() []any {
+	values := make([]any, 0, len(impl.cursor)*(len(impl.cursor)+1)/2)
+
+	for idx := range impl.order {
+		values = append(values, impl.cursor[:idx+1]...)
+	}
+
+	return values
+}
+
+// And generates new condition which true on all conditions met.
+// Implements Condition.
+func (impl keyset) And(conditions ...Condition) Condition {
+	return And(impl, conditions...)
+}
+
+// Or generates new condition group which true on either initial condition is true or all of additional are true.
+// Implements Condition.
+func (impl keyset) Or(conditions ...Condition) Condition {
+	return Or(impl, conditions...)
+}
+
+// After returns a copy of SelectManyBuilder selecting rows following the row having specified cursor values
+// in the query ordering, i.e. the next page of keyset pagination. Use Limit to set page size.
+// Cursor should have a value of every OrderBy field and the last ordering field should be unique,
+// i.e. id, to break ties. Mixed ordering directions are supported, NULL values are not.
+// Nil cursor disables keyset pagination to get the first page.
+// Note query build fails if cursor values count differs from ordering fields count.
+func (query SelectManyBuilder) After(cursor Cursor) SelectManyBuilder {
+	query.cursor = cursor
+	query.before = false
+
+	return query
+}
+
+// Before returns a copy of SelectManyBuilder selecting rows preceding the row having specified cursor values
+// in the query ordering, i.e. the previous page of keyset pagination. Use Limit to set page size.
+// Query is rendered with reversed ordering to get the closest preceding rows,
+// so returned rows should be reversed by caller to restore requested ordering.
+// Cursor requirements are the same as of After.
+func (query SelectManyBuilder) Before(cursor Cursor) SelectManyBuilder {
+	query.cursor = cursor
+	query.before = true
+
+	return query
+}
+
+// validateCursor returns error if cursor set does not match ordering fields.
+func (query SelectManyBuilder) validateCursor() error {
+	switch {
+	case query.cursor == nil:
+		return nil
+	case len(query.order) == 0:
+		return fmt.Errorf("%w: keyset pagination requires ordering", Error)
+	case len(query.cursor) != len(query.order):
+		return fmt.Errorf("%w: cursor has %d values while query ordered by %d fields",
+			Error, len(query.cursor), len(query.order))
+	}
+
+	return nil
+}
+
+// withKeyset returns a copy of SelectManyBuilder having keyset condition added to WHERE clause
+// and ordering reversed when selecting rows before cursor. Returns unchanged copy if cursor does not match ordering.
+func (query SelectManyBuilder) withKeyset() SelectManyBuilder {
+	if query.cursor == nil || query.validateCursor() != nil {
+		return query
+	}
+
+	condition := keyset{
+		BaseCondition: *newBaseCondition(LogicalAND, false),
+		order:         query.order,
+		cursor:        query.cursor,
+		before:        query.before,
+	}
+
+	if query.where.group.hasOne(LogicalOR) || hasGroups(query.where.group.conditions) {
+		// keep existing alternatives apart from keyset condition
+		query.where = WhereClause{group: NewGroup(LogicalAND, query.where.group.WithBrackets())}
+	}
+
+	query.where = query.where.GroupAND(condition)
+
+	if query.before {
+		reversed := make([]FieldSorting, len(query.order))
+		for idx, sorting := range query.order {
+			reversed[idx] = sorting
+			reversed[idx].direction = Descending
+
+			if sorting.direction == Descending {
+				reversed[idx].direction = Ascending
+			}
+		}
+
+		query.order = reversed
+	}
+
+	query.cursor = nil
+
+	return query
+}
+
+// hasGroups returns true if any of conditions is a conditions group which could contain alternatives.
+func hasGroups(conditions []Condition) bool {
+	for _, condition := range conditions {
+		if _, isGroup := condition.(Group); isGroup {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cursorValue is a single Cursor value encoded with its type to restore it exactly.
+type cursorValue struct {
+	Type  string          `json:"t"`
+	Value json.RawMessage `json:"v,omitempty"`
+}
+
+// EncodeCursor encodes Cursor into opaque URL safe token to pass to API clients.
+// Token is signed by HMAC-SHA256 using specified secret key, so DecodeCursor detects any modifications.
+// Supports nil, booleans, integers, floats, strings, byte slices, time.Time and driver.Valuer values.
+// Note token is not encrypted, clients could read cursor values.
+func EncodeCursor(cursor Cursor, key []byte) (string, error) {
+	if len(key) == 0 {
+		return "", fmt.Errorf("%w: cursor key is empty", Error)
+	}
+
+	values := make([]cursorValue, len(cursor))
+
+	for idx, value := range cursor {
+		encoded, err := encodeCursorValue(value)
+		if err != nil {
+			return "", err
+		}
+
+		values[idx] = encoded
+	}
+
+	payload, err := json.Marshal(values)
+	if err != nil {
+		return "", fmt.Errorf("%w: encode cursor: %v", Error, err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(cursorSignature(payload, key)), nil
+}
+
+// DecodeCursor decodes Cursor from token generated by EncodeCursor using the same secret key.
+// Integers are decoded as int64 or uint64, floats as float64 and times as time.Time.
+// Returns error if token is malformed or its signature does not match.
+func DecodeCursor(token string, key []byte) (Cursor, error) {
+	if len(key) == 0 {
+		return nil, fmt.Errorf("%w: cursor key is empty", Error)
+	}
+
+	encodedPayload, encodedSignature, found := strings.Cut(token, ".")
+	if !found {
+		return nil, fmt.Errorf("%w: malformed cursor", Error)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", Error)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, cursorSignature(payload, key)) {
+		return nil, fmt.Errorf("%w: invalid cursor signature", Error)
+	}
+
+	values := make([]cursorValue, 0)
+	if err = json.Unmarshal(payload, &values); err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor: %v", Error, err)
+	}
+
+	cursor := make(Cursor, len(values))
+
+	for idx, value := range values {
+		if cursor[idx], err = decodeCursorValue(value); err != nil {
+			return nil, err
+		}
+	}
+
+	return cursor, nil
+}
+
+// cursorSignature returns HMAC-SHA256 signature of cursor payload.
+func cursorSignature(payload []byte, key []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload)
+
+	return mac.Sum(nil)
+}
+
+// encodeCursorValue encodes single cursor value with its type tag.
+func encodeCursorValue(value any) (encoded cursorValue, err error) {
+	var typed any
+
+	switch converted := value.(type) {
+	case nil:
+		return cursorValue{Type: "n"}, nil
+	case driver.Valuer:
+		if value, err = converted.Value(); err != nil {
+			return encoded, fmt.Errorf("%w: encode cursor value: %v", Error, err)
+		}
+
+		return encodeCursorValue(value)
+	case bool:
+		encoded.Type, typed = "b", converted
+	case int, int8, int16, int32, int64:
+		encoded.Type, typed = "i", converted
+	case uint, uint8, uint16, uint32, uint64:
+		encoded.Type, typed = "u", converted
+	case float32, float64:
+		encoded.Type, typed = "f", converted
+	case string:
+		encoded.Type, typed = "s", converted
+	case []byte:
+		encoded.Type, typed = "x", converted
+	case time.Time:
+		encoded.Type, typed = "t", converted.Format(time.RFC3339Nano)
+	default:
+		return encoded, fmt.Errorf("%w: unsupported cursor value type %T", Error, value)
+	}
+
+	if encoded.Value, err = json.Marshal(typed); err != nil {
+		return encoded, fmt.Errorf("%w: encode cursor value: %v", Error, err)
+	}
+
+	return encoded, nil
+}
+
+// decodeCursorValue decodes single cursor value according to its type tag.
+func decodeCursorValue(encoded cursorValue) (value any, err error) {
+	switch encoded.Type {
+	case "n":
+		return nil, nil
+	case "b":
+		value, err = decodeCursorJSON[bool](encoded.Value)
+	case "i":
+		value, err = decodeCursorJSON[int64](encoded.Value)
+	case "u":
+		value, err = decodeCursorJSON[uint64](encoded.Value)
+	case "f":
+		value, err = decodeCursorJSON[float64](encoded.Value)
+	case "s":
+		value, err = decodeCursorJSON[string](encoded.Value)
+	case "x":
+		value, err = decodeCursorJSON[[]byte](encoded.Value)
+	case "t":
+		var formatted string
+		if formatted, err = decodeCursorJSON[string](encoded.Value); err == nil {
+			value, err = time.Parse(time.RFC3339Nano, formatted)
+		}
+	default:
+		return nil, fmt.Errorf("%w: malformed cursor: unknown value type %q", Error, encoded.Type)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor: %v", Error, err)
+	}
+
+	return value, nil
+}
+
+// decodeCursorJSON decodes JSON encoded cursor value of specified type.
+func decodeCursorJSON[T any](data json.RawMessage) (value T, err error) {
+	err = json.Unmarshal(data, &value)
+	return value, err
+}
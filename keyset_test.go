@@ -0,0 +1,109 @@
+package query_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/query"
+)
+
+func TestSelectManyBuilder_After(t *testing.T) {
+	posts := query.SelectManyFrom("posts").OrderBy(query.DESC("created"), query.ASC("id")).Limit(10)
+
+	tests := []struct {
+		name       string
+		builder    query.SelectManyBuilder
+		dialect    query.Dialect
+		wantSQL    string
+		wantParams []any
+		wantErr    bool
+	}{
+		{
+			"single_field",
+			query.SelectManyFrom("posts").OrderBy(query.ASC("id")).Limit(10).After(query.Cursor{7}),
+			query.PostgreSQL,
+			"SELECT * FROM posts WHERE (id>$1) ORDER BY id ASC LIMIT $2",
+			[]any{7, uint(10)},
+			false,
+		},
+		{
+			"mixed_directions",
+			posts.After(query.Cursor{5, 7}),
+			query.PostgreSQL,
+			"SELECT * FROM posts WHERE (created<$1 OR (created=$2 AND id>$3)) ORDER BY created DESC, id ASC LIMIT $4",
+			[]any{5, 5, 7, uint(10)},
+			false,
+		},
+		{
+			"before_reverses_ordering",
+			posts.Where(query.EqualTo("author", 1)).Before(query.Cursor{5, 7}),
+			query.MySQL,
+			"SELECT * FROM posts WHERE author=? AND (created>? OR (created=? AND id<?)) ORDER BY created ASC, id DESC LIMIT ?",
+			[]any{1, 5, 5, 7, uint(10)},
+			false,
+		},
+		{
+			"alternatives_bracketed",
+			posts.Where(query.EqualTo("author", 1).Or(query.EqualTo("editor", 1))).After(query.Cursor{5, 7}),
+			query.SQLServer,
+			"SELECT * FROM posts WHERE (author=@p1 OR editor=@p2) AND (created<@p3 OR (created=@p4 AND id>@p5)) " +
+				"ORDER BY created DESC, id ASC OFFSET 0 ROWS FETCH NEXT @p6 ROWS ONLY",
+			[]any{1, 1, 5, 5, 7, uint(10)},
+			false,
+		},
+		{
+			"nil_cursor",
+			posts.After(nil),
+			query.PostgreSQL,
+			"SELECT * FROM posts ORDER BY created DESC, id ASC LIMIT $1",
+			[]any{uint(10)},
+			false,
+		},
+		{"cursor_mismatch", posts.After(query.Cursor{5}), query.PostgreSQL, "", nil, true},
+		{"no_ordering", query.SelectManyFrom("posts").After(query.Cursor{5}), query.PostgreSQL, "", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, params, err := tt.builder.BuildQueryAndParamsFor(tt.dialect)
+			if tt.wantErr {
+				require.ErrorIs(t, err, query.Error)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSQL, sql)
+			require.Equal(t, tt.wantParams, params)
+		})
+	}
+}
+
+func TestEncodeCursor(t *testing.T) {
+	key := []byte("secret")
+	cursor := query.Cursor{
+		nil, true, int64(-5), uint64(7), 1.5, "text", []byte{1, 2},
+		time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC),
+	}
+
+	token, err := query.EncodeCursor(cursor, key)
+	require.NoError(t, err)
+
+	decoded, err := query.DecodeCursor(token, key)
+	require.NoError(t, err)
+	require.Equal(t, cursor, decoded)
+
+	_, err = query.DecodeCursor(token, []byte("another"))
+	require.ErrorIs(t, err, query.Error)
+
+	payload, signature, _ := strings.Cut(token, ".")
+	_, err = query.DecodeCursor(payload[:len(payload)-2]+"AA."+signature, key)
+	require.ErrorIs(t, err, query.Error)
+
+	_, err = query.DecodeCursor("malformed", key)
+	require.ErrorIs(t, err, query.Error)
+
+	_, err = query.EncodeCursor(query.Cursor{struct{}{}}, key)
+	require.ErrorIs(t, err, query.Error)
+}
@@ -3,18 +3,22 @@ package query
 type SQLKeyWord string
 
 const (
-	kwSelect SQLKeyWord = "SELECT"
-	kwUpdate SQLKeyWord = "UPDATE"
-	kwInsert SQLKeyWord = "INSERT"
-	kwInto   SQLKeyWord = "INTO"
-	kwDelete SQLKeyWord = "DELETE"
-	kwFrom   SQLKeyWord = "FROM"
-	kwWhere  SQLKeyWord = "WHERE"
-	kwCount  SQLKeyWord = "COUNT"
-	kwAs     SQLKeyWord = "AS"
-	kwOn     SQLKeyWord = "ON"
-	kwValues SQLKeyWord = "VALUES"
-	kwSet    SQLKeyWord = "SET"
+	kwSelect    SQLKeyWord = "SELECT"
+	kwUpdate    SQLKeyWord = "UPDATE"
+	kwInsert    SQLKeyWord = "INSERT"
+	kwInto      SQLKeyWord = "INTO"
+	kwDelete    SQLKeyWord = "DELETE"
+	kwFrom      SQLKeyWord = "FROM"
+	kwWhere     SQLKeyWord = "WHERE"
+	kwCount     SQLKeyWord = "COUNT"
+	kwAs        SQLKeyWord = "AS"
+	kwOn        SQLKeyWord = "ON"
+	kwValues    SQLKeyWord = "VALUES"
+	kwSet       SQLKeyWord = "SET"
+	kwReturning SQLKeyWord = "RETURNING"
+	kwGroupBy   SQLKeyWord = "GROUP BY"
+	kwHaving    SQLKeyWord = "HAVING"
+	kwDistinct  SQLKeyWord = "DISTINCT"
 )
 
 // String returns string representation of SQLKeyWord.
@@ -0,0 +1,145 @@
+package query
+
+import (
+	"reflect"
+	"strings"
+)
+
+// between implements any type fields conditions to match records where field value is in closed range.
+type between struct {
+	BaseCondition
+	FieldDefinition
+	low  any
+	high any
+}
+
+// ApplyFieldTable makes a copy of between condition with updated FieldDefinition table name.
+// Implements Condition.
+func (impl between) ApplyFieldTable(table TableName) Condition {
+	impl.FieldDefinition.tableName = string(table)
+	return impl
+}
+
+// ApplyFieldSpec makes a copy of Condition with updated FieldDefinition if fieldName match.
+// If FieldName is not matched it does nothing.
+// Implements Condition.
+func (impl between) ApplyFieldSpec(spec FieldDefinition) Condition {
+	if impl.FieldDefinition.fieldName == spec.fieldName {
+		impl.FieldDefinition = spec
+	}
+
+	return impl
+}
+
+// Join returns a copy of Group having JoinType set to specified value.
+func (impl between) Join(newJoinType JoinType) Condition {
+	impl.BaseCondition = impl.BaseCondition.Join(newJoinType)
+	return impl
+}
+
+// Negate returns a copy of BaseCondition having IsNegate set to specified value.
+func (impl between) Negate(newNegateIndicator bool) Condition {
+	impl.BaseCondition = impl.BaseCondition.Negate(newNegateIndicator)
+	return impl
+}
+
+// RenderDialect renders SQL SELECT clause part for current field.
+// Renders two parameters substitutions using specified Dialect placeholders, negated as NOT BETWEEN.
+// Implements DialectClauseRenderer.
+func (impl between) RenderDialect(dialect Dialect, paramNum int) string {
+	tokens := []string{impl.renderOperandFor(dialect), "BETWEEN"}
+	if impl.IsNegate() {
+		tokens = []string{impl.renderOperandFor(dialect), impl.RenderNegate(), "BETWEEN"}
+	}
+
+	tokens = append(tokens, dialect.Placeholder(paramNum+1), "AND", dialect.Placeholder(paramNum+2))
+
+	return strings.Join(tokens, " ")
+}
+
+// Render renders SQL SELECT clause part for current field.
+func (impl between) Render(paramNum int) string {
+	return impl.RenderDialect(PostgreSQL, paramNum)
+}
+
+// RenderSQL renders SQL clause or its part.
+// Implementation should render parameters substitutions using standard sql "?"(question) character.
+func (impl between) RenderSQL() (sql string) {
+	return impl.RenderDialect(rawDialect, 0)
+}
+
+// Values returns range low and high bounds.
+// Implements ValuesProvider.
+func (impl between) Values() []any {
+	return append(FieldValue{value: impl.low}.Values(), FieldValue{value: impl.high}.Values()...)
+}
+
+// And generates new condition which true on all conditions met.
+// Implements Condition.
+func (impl between) And(conditions ...Condition) Condition {
+	return And(impl, conditions...)
+}
+
+// Or generates new condition group which true on either initial condition is true or all of additional are true.
+// Implements Condition.
+func (impl between) Or(conditions ...Condition) Condition {
+	return Or(impl, conditions...)
+}
+
+// Between generates Condition for any field type to match records having field values in closed range
+// from low to high including both bounds, i.e. Between("created", from, to) renders created BETWEEN $1 AND $2.
+// Field could be a string, FieldName or FieldDefinition including expressions such as Lower.
+// Use Not(Between(...)) to render NOT BETWEEN condition.
+func Between[T FieldNameParameter](field T, low any, high any) Condition {
+	return &between{
+		BaseCondition:   *newBaseCondition(LogicalAND, false),
+		FieldDefinition: Field(field),
+		low:             low,
+		high:            high,
+	}
+}
+
+// InRange generates Condition to match records having field values in half-open range, including from
+// and excluding to bound, i.e. InRange("created", from, to) renders created>=$1 AND created<$2.
+// Either bound could be nil or nil pointer to leave range unbounded from that side, so condition
+// degrades to single comparison. Unbounded from both sides range matches all not NULL field values.
+func InRange[T FieldNameParameter](field T, from any, to any) Condition {
+	switch {
+	case isNilBound(from) && isNilBound(to):
+		return Not(IsNullExpr(field))
+	case isNilBound(to):
+		return GreaterOrEqualExpr(field, from)
+	case isNilBound(from):
+		return LessExpr(field, to)
+	default:
+		return And(GreaterOrEqualExpr(field, from), LessExpr(field, to))
+	}
+}
+
+// InRangeInclusive generates Condition to match records having field values in closed range
+// including both bounds. Renders BETWEEN condition when both bounds set.
+// Either bound could be nil or nil pointer to leave range unbounded from that side, so condition
+// degrades to single comparison. Unbounded from both sides range matches all not NULL field values.
+func InRangeInclusive[T FieldNameParameter](field T, from any, to any) Condition {
+	switch {
+	case isNilBound(from) && isNilBound(to):
+		return Not(IsNullExpr(field))
+	case isNilBound(to):
+		return GreaterOrEqualExpr(field, from)
+	case isNilBound(from):
+		return LessOrEqualExpr(field, to)
+	default:
+		return Between(field, from, to)
+	}
+}
+
+// isNilBound returns true if range bound is nil or nil pointer.
+func isNilBound(bound any) bool {
+	if bound == nil {
+		return true
+	}
+
+	reflected := reflect.ValueOf(bound)
+
+	return reflected.Kind() == reflect.Pointer && reflected.IsNil()
+}
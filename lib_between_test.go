@@ -0,0 +1,79 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/query"
+)
+
+func Test_Between_Render(t *testing.T) {
+	var noTime *time.Time
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	tests := []struct {
+		name       string
+		cond       query.Condition
+		paramCount int
+		want       string
+		values     []interface{}
+	}{
+		{"between",
+			query.Between("f1", 1, 5),
+			0, "f1 BETWEEN $1 AND $2", []interface{}{1, 5},
+		},
+		{"not_between",
+			query.Not(query.Between("f1", "a", "c")),
+			2, "f1 NOT BETWEEN $3 AND $4", []interface{}{"a", "c"},
+		},
+		{"between_and",
+			query.Between("f1", 1, 5).And(query.EqualTo("f2", 3)),
+			0, "f1 BETWEEN $1 AND $2 AND f2=$3", []interface{}{1, 5, 3},
+		},
+		{"in_range",
+			query.InRange("created", from, to),
+			0, "created>=$1 AND created<$2", []interface{}{from, to},
+		},
+		{"not_in_range",
+			query.Not(query.InRange("created", from, to)),
+			0, "NOT (created>=$1 AND created<$2)", []interface{}{from, to},
+		},
+		{"in_range_from",
+			query.InRange("created", from, nil),
+			1, "created>=$2", []interface{}{from},
+		},
+		{"in_range_to",
+			query.InRange("created", noTime, to),
+			0, "created<$1", []interface{}{to},
+		},
+		{"in_range_unbounded",
+			query.InRange("created", nil, noTime),
+			0, "created IS NOT NULL", []interface{}{},
+		},
+		{"in_range_inclusive",
+			query.InRangeInclusive("created", from, to),
+			0, "created BETWEEN $1 AND $2", []interface{}{from, to},
+		},
+		{"in_range_inclusive_to",
+			query.InRangeInclusive("created", nil, to),
+			0, "created<=$1", []interface{}{to},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.cond.Render(tt.paramCount))
+			require.Equal(t, tt.values, tt.cond.Values())
+		})
+	}
+}
+
+func Test_Between_RenderDialect(t *testing.T) {
+	cond := query.Between(query.Table("orders").Field("total"), 10, 20)
+
+	require.Equal(t, "orders.total BETWEEN ? AND ?", cond.RenderSQL())
+	require.Equal(t, "o.total BETWEEN @p2 AND @p3", cond.ApplyFieldTable("o").(query.DialectClauseRenderer).RenderDialect(query.SQLServer, 1))
+}
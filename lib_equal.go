@@ -1,7 +1,6 @@
 package query
 
 import (
-	"strconv"
 	"strings"
 )
 
@@ -38,27 +37,28 @@ func (impl equalTo) Negate(newNegateIndicator bool) Condition {
 	return impl
 }
 
-// Render renders SQL SELECT clause part for current field.
-func (impl equalTo) Render(paramNum int) string {
+// RenderDialect renders SQL SELECT clause part for current field.
+// Renders parameters substitutions using specified Dialect placeholders.
+// Implements DialectClauseRenderer.
+func (impl equalTo) RenderDialect(dialect Dialect, paramNum int) string {
 	var tokens []string
 	if impl.IsNegate() {
-		tokens = []string{impl.RenderNegate() + " ", impl.RenderSpec(), "=$" + strconv.Itoa(paramNum+1)}
+		tokens = []string{impl.RenderNegate() + " ", impl.renderOperandFor(dialect), "=" + dialect.Placeholder(paramNum+1)}
 	} else {
-		tokens = []string{impl.RenderSpec(), "=$" + strconv.Itoa(paramNum+1)}
+		tokens = []string{impl.renderOperandFor(dialect), "=" + dialect.Placeholder(paramNum+1)}
 	}
 	return strings.Join(tokens, "")
 }
 
+// Render renders SQL SELECT clause part for current field.
+func (impl equalTo) Render(paramNum int) string {
+	return impl.RenderDialect(PostgreSQL, paramNum)
+}
+
 // RenderSQL renders SQL clause or its part.
 // Implementation should render parameters substitutions using standard sql "?"(question) character.
 func (impl equalTo) RenderSQL() (sql string) {
-	var tokens []string
-	if impl.IsNegate() {
-		tokens = []string{impl.RenderNegate() + " ", impl.RenderSpec(), "=?"}
-	} else {
-		tokens = []string{impl.RenderSpec(), "=?"}
-	}
-	return strings.Join(tokens, "")
+	return impl.RenderDialect(rawDialect, 0)
 }
 
 // And generates new condition which true on all conditions met.
@@ -74,9 +74,16 @@ func (impl equalTo) Or(conditions ...Condition) Condition {
 }
 
 // EqualTo generates Condition for any field type equalTo to value.
+// Use EqualToExpr to match expressions such as Sum or Lower.
 func EqualTo(fieldName FieldName, value interface{}) Condition {
+	return EqualToExpr(fieldName, value)
+}
+
+// EqualToExpr is a generic form of EqualTo taking string, FieldName or FieldDefinition
+// including expressions such as Sum or Lower as field.
+func EqualToExpr[T FieldNameParameter](field T, value interface{}) Condition {
 	return &equalTo{
 		BaseCondition: *newBaseCondition(LogicalAND, false),
-		FieldValue:    *NewFieldValue(fieldName, value),
+		FieldValue:    FieldValue{FieldDefinition: Field(field), value: value},
 	}
 }
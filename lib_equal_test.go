@@ -122,3 +122,10 @@ func Test_equalTo_RenderSQL(t *testing.T) {
 		})
 	}
 }
+
+func Test_EqualTo_FunctionValue(t *testing.T) {
+	var compare func(query.FieldName, interface{}) query.Condition = query.EqualTo
+
+	require.Equal(t, "f1=$1", compare("f1", 1).Render(0))
+	require.Equal(t, "SUM(total)=$1", query.EqualToExpr(query.Sum("total"), 1).Render(0))
+}
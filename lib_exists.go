@@ -0,0 +1,98 @@
+package query
+
+// exists implements conditions to match records when subquery returns any rows.
+type exists struct {
+	BaseCondition
+	query SubQuery
+}
+
+// FieldName returns empty string as condition is not applied to any field. Implements Condition.
+func (impl exists) FieldName() FieldName {
+	return ""
+}
+
+// ApplyFieldTable returns a copy of condition as it has no field to update.
+// Implements Condition.
+func (impl exists) ApplyFieldTable(TableName) Condition {
+	return impl
+}
+
+// ApplyFieldSpec returns a copy of condition as it has no field to update.
+// Implements Condition.
+func (impl exists) ApplyFieldSpec(FieldDefinition) Condition {
+	return impl
+}
+
+// Join returns a copy of Group having JoinType set to specified value.
+func (impl exists) Join(newJoinType JoinType) Condition {
+	impl.BaseCondition = impl.BaseCondition.Join(newJoinType)
+	return impl
+}
+
+// Negate returns a copy of BaseCondition having IsNegate set to specified value.
+func (impl exists) Negate(newNegateIndicator bool) Condition {
+	impl.BaseCondition = impl.BaseCondition.Negate(newNegateIndicator)
+	return impl
+}
+
+// RenderDialect renders EXISTS condition clause with subquery.
+// Subquery parameters substitutions are numbered after paramNum using specified Dialect placeholders.
+// Implements DialectClauseRenderer.
+func (impl exists) RenderDialect(dialect Dialect, paramNum int) string {
+	sql := "EXISTS (" + impl.query.RenderDialect(dialect, paramNum) + ")"
+	if impl.IsNegate() {
+		return impl.RenderNegate() + " " + sql
+	}
+
+	return sql
+}
+
+// Render renders EXISTS condition clause with subquery.
+func (impl exists) Render(paramNum int) string {
+	return impl.RenderDialect(PostgreSQL, paramNum)
+}
+
+// RenderSQL renders SQL clause or its part.
+// Implementation should render parameters substitutions using standard sql "?"(question) character.
+func (impl exists) RenderSQL() (sql string) {
+	return impl.RenderDialect(rawDialect, 0)
+}
+
+// Values returns subquery parameters ordered as PostgreSQL expects, use ValuesFor to get them for another Dialect.
+// Implements ValuesProvider.
+func (impl exists) Values() []any {
+	return impl.ValuesFor(PostgreSQL)
+}
+
+// ValuesFor returns subquery parameters ordered as query rendered with specified Dialect expects.
+// Implements DialectValuesProvider.
+func (impl exists) ValuesFor(dialect Dialect) []any {
+	return impl.query.ValuesFor(dialect)
+}
+
+// And generates new condition which true on all conditions met.
+// Implements Condition.
+func (impl exists) And(conditions ...Condition) Condition {
+	return And(impl, conditions...)
+}
+
+// Or generates new condition group which true on either initial condition is true or all of additional are true.
+// Implements Condition.
+func (impl exists) Or(conditions ...Condition) Condition {
+	return Or(impl, conditions...)
+}
+
+// Exists generates Condition to match records when subquery returns any rows.
+// Takes SubQuery such as BaseSelectBuilder, its parameters are numbered after parameters of preceding conditions.
+func Exists(query SubQuery) Condition {
+	return &exists{
+		BaseCondition: *newBaseCondition(LogicalAND, false),
+		query:         query,
+	}
+}
+
+// NotExists generates Condition to match records when subquery returns no rows.
+// Shortcut to Not(Exists(query)).
+func NotExists(query SubQuery) Condition {
+	return Not(Exists(query))
+}
@@ -0,0 +1,92 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/query"
+)
+
+func Test_Exists_Render(t *testing.T) {
+	paid := query.SelectFrom("payments").Fields(query.Field("id")).Where(query.EqualTo("status", "paid"))
+
+	tests := []struct {
+		name       string
+		cond       query.Condition
+		paramCount int
+		want       string
+		values     []interface{}
+	}{
+		{"exists",
+			query.Exists(paid),
+			0, "EXISTS (SELECT id FROM payments WHERE status=$1)", []interface{}{"paid"},
+		},
+		{"exists_with_offset",
+			query.Exists(paid),
+			2, "EXISTS (SELECT id FROM payments WHERE status=$3)", []interface{}{"paid"},
+		},
+		{"not_exists",
+			query.NotExists(paid),
+			1, "NOT EXISTS (SELECT id FROM payments WHERE status=$2)", []interface{}{"paid"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.cond.Render(tt.paramCount))
+			require.Equal(t, tt.values, tt.cond.Values())
+		})
+	}
+}
+
+func Test_Exists_Select(t *testing.T) {
+	sql, params, err := query.SelectManyFrom("orders").
+		Where(
+			query.EqualTo("status", "new"),
+			query.NotExists(query.SelectFrom("refunds").Where(query.GreaterThan("amount", 10))),
+			query.In("customer_id", query.SelectFrom("customers").
+				Fields(query.Field("id")).
+				Where(query.EqualTo("vip", true))),
+		).
+		Limit(5).
+		BuildQueryAndParamsFor(query.SQLServer)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM orders WHERE status=@p1 "+
+		"AND NOT EXISTS (SELECT * FROM refunds WHERE amount>@p2) "+
+		"AND customer_id IN (SELECT id FROM customers WHERE vip=@p3) "+
+		"ORDER BY (SELECT NULL) OFFSET 0 ROWS FETCH NEXT @p4 ROWS ONLY", sql)
+	require.Equal(t, []any{"new", 10, true, uint(5)}, params)
+}
+
+func Test_Exists_PaginatedSubQuery(t *testing.T) {
+	recent := query.SelectManyFrom("payments").
+		Where(query.EqualTo("x", 7)).
+		OrderBy(query.ASC("id")).
+		Offset(5).
+		Limit(10)
+
+	tests := []struct {
+		name    string
+		dialect query.Dialect
+		want    string
+		params  []any
+	}{
+		{"postgresql", query.PostgreSQL,
+			"SELECT * FROM orders WHERE EXISTS (SELECT * FROM payments WHERE x=$1 ORDER BY id ASC OFFSET $2 LIMIT $3)",
+			[]any{7, uint(5), uint(10)}},
+		{"mysql", query.MySQL,
+			"SELECT * FROM orders WHERE EXISTS (SELECT * FROM payments WHERE x=? ORDER BY id ASC LIMIT ? OFFSET ?)",
+			[]any{7, uint(10), uint(5)}},
+		{"sqlite", query.SQLite,
+			"SELECT * FROM orders WHERE EXISTS (SELECT * FROM payments WHERE x=? ORDER BY id ASC LIMIT ? OFFSET ?)",
+			[]any{7, uint(10), uint(5)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, params, err := query.SelectFrom("orders").Where(query.Exists(recent)).BuildQueryAndParamsFor(tt.dialect)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, sql)
+			require.Equal(t, tt.params, params)
+		})
+	}
+}
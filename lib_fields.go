@@ -0,0 +1,134 @@
+package query
+
+// fieldsComparison implements conditions to match records comparing values of two fields of the same row.
+type fieldsComparison struct {
+	BaseCondition
+	left     FieldDefinition
+	right    FieldDefinition
+	operator string
+}
+
+// FieldName returns left field name. Implements Condition.
+func (impl fieldsComparison) FieldName() FieldName {
+	return impl.left.FieldName()
+}
+
+// ApplyFieldTable makes a copy of condition with both fields table name updated.
+// Implements Condition.
+func (impl fieldsComparison) ApplyFieldTable(table TableName) Condition {
+	impl.left.tableName = string(table)
+	impl.right.tableName = string(table)
+
+	return impl
+}
+
+// ApplyFieldSpec makes a copy of Condition with either field FieldDefinition updated if fieldName match.
+// If FieldName is not matched it does nothing.
+// Implements Condition.
+func (impl fieldsComparison) ApplyFieldSpec(spec FieldDefinition) Condition {
+	if impl.left.fieldName == spec.fieldName {
+		impl.left = spec
+	}
+
+	if impl.right.fieldName == spec.fieldName {
+		impl.right = spec
+	}
+
+	return impl
+}
+
+// Join returns a copy of Group having JoinType set to specified value.
+func (impl fieldsComparison) Join(newJoinType JoinType) Condition {
+	impl.BaseCondition = impl.BaseCondition.Join(newJoinType)
+	return impl
+}
+
+// Negate returns a copy of BaseCondition having IsNegate set to specified value.
+func (impl fieldsComparison) Negate(newNegateIndicator bool) Condition {
+	impl.BaseCondition = impl.BaseCondition.Negate(newNegateIndicator)
+	return impl
+}
+
+// RenderDialect renders SQL SELECT clause part comparing both fields quoted according to specified Dialect.
+// Renders no parameters substitutions. Implements DialectClauseRenderer.
+func (impl fieldsComparison) RenderDialect(dialect Dialect, _ int) string {
+	sql := impl.left.renderOperandFor(dialect) + impl.operator + impl.right.renderOperandFor(dialect)
+	if impl.IsNegate() {
+		return impl.RenderNegate() + " " + sql
+	}
+
+	return sql
+}
+
+// Render renders SQL SELECT clause part comparing both fields.
+func (impl fieldsComparison) Render(paramNum int) string {
+	return impl.RenderDialect(PostgreSQL, paramNum)
+}
+
+// RenderSQL renders SQL clause or its part.
+// Implementation should render parameters substitutions using standard sql "?"(question) character.
+func (impl fieldsComparison) RenderSQL() (sql string) {
+	return impl.RenderDialect(rawDialect, 0)
+}
+
+// Values returns empty list as fields comparison has no parameters.
+// Implements ValuesProvider.
+func (impl fieldsComparison) Values() []any {
+	return []any{}
+}
+
+// And generates new condition which true on all conditions met.
+// Implements Condition.
+func (impl fieldsComparison) And(conditions ...Condition) Condition {
+	return And(impl, conditions...)
+}
+
+// Or generates new condition group which true on either initial condition is true or all of additional are true.
+// Implements Condition.
+func (impl fieldsComparison) Or(conditions ...Condition) Condition {
+	return Or(impl, conditions...)
+}
+
+// compareFields makes fields comparison condition using specified operator.
+func compareFields[L FieldNameParameter, R FieldNameParameter](left L, operator string, right R) Condition {
+	return &fieldsComparison{
+		BaseCondition: *newBaseCondition(LogicalAND, false),
+		left:          Field(left),
+		right:         Field(right),
+		operator:      operator,
+	}
+}
+
+// EqualToField generates Condition to match records having left field value equal to right field value,
+// i.e. EqualToField(Table("a").Field("owner_id"), Table("b").Field("user_id")) renders a.owner_id=b.user_id.
+// Fields could be strings, FieldName or FieldDefinition including expressions such as Lower.
+func EqualToField[L FieldNameParameter, R FieldNameParameter](left L, right R) Condition {
+	return compareFields(left, "=", right)
+}
+
+// NotEqualToField generates Condition to match records having left field value not equal to right field value.
+// Renders SQL <> operator, note NULL values never match.
+func NotEqualToField[L FieldNameParameter, R FieldNameParameter](left L, right R) Condition {
+	return compareFields(left, "<>", right)
+}
+
+// GreaterThanField generates Condition to match records having left field value greater than right field value,
+// i.e. GreaterThanField("shipped_at", "created_at").
+func GreaterThanField[L FieldNameParameter, R FieldNameParameter](left L, right R) Condition {
+	return compareFields(left, ">", right)
+}
+
+// GreaterOrEqualField generates Condition to match records having left field value greater or equal to right field value.
+func GreaterOrEqualField[L FieldNameParameter, R FieldNameParameter](left L, right R) Condition {
+	return compareFields(left, ">=", right)
+}
+
+// LessField generates Condition to match records having left field value less than right field value.
+func LessField[L FieldNameParameter, R FieldNameParameter](left L, right R) Condition {
+	return compareFields(left, "<", right)
+}
+
+// LessOrEqualField generates Condition to match records having left field value less or equal to right field value.
+func LessOrEqualField[L FieldNameParameter, R FieldNameParameter](left L, right R) Condition {
+	return compareFields(left, "<=", right)
+}
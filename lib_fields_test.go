@@ -0,0 +1,70 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/query"
+)
+
+func Test_FieldsComparison_Render(t *testing.T) {
+	orders := query.Table("orders")
+
+	tests := []struct {
+		name       string
+		cond       query.Condition
+		paramCount int
+		want       string
+		values     []interface{}
+	}{
+		{"eq_fields",
+			query.EqualToField(query.Table("a").Field("owner_id"), query.Table("b").Field("user_id")),
+			0, "a.owner_id=b.user_id", []interface{}{},
+		},
+		{"ne_fields",
+			query.NotEqualToField("f1", "f2"),
+			0, "f1<>f2", []interface{}{},
+		},
+		{"gt_fields",
+			query.GreaterThanField(orders.Field("shipped_at"), orders.Field("created_at")),
+			3, "orders.shipped_at>orders.created_at", []interface{}{},
+		},
+		{"gte_fields",
+			query.GreaterOrEqualField("f1", query.FieldName("f2")),
+			0, "f1>=f2", []interface{}{},
+		},
+		{"lt_expression",
+			query.LessField(query.Lower("f1"), query.Lower("f2")),
+			0, "LOWER(f1)<LOWER(f2)", []interface{}{},
+		},
+		{"not_lte_fields",
+			query.Not(query.LessOrEqualField("f1", "f2")),
+			0, "NOT f1<=f2", []interface{}{},
+		},
+		{"fields_and_value",
+			query.GreaterThanField("f1", "f2").And(query.EqualTo("f3", 1)),
+			0, "f1>f2 AND f3=$1", []interface{}{1},
+		},
+		{"alias_ignored",
+			query.EqualToField(query.Field("f1").As("a1"), "f2"),
+			0, "f1=f2", []interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.cond.Render(tt.paramCount))
+			require.Equal(t, tt.values, tt.cond.Values())
+		})
+	}
+}
+
+func Test_FieldsComparison_Apply(t *testing.T) {
+	cond := query.GreaterThanField("shipped_at", "created_at")
+
+	require.Equal(t, "o.shipped_at>o.created_at", cond.ApplyFieldTable("o").RenderSQL())
+	require.Equal(t, "shipped_at>b.created_at",
+		cond.ApplyFieldSpec(query.Table("b").Field("created_at")).RenderSQL())
+	require.Equal(t, "`o`.`shipped_at`>`o`.`created_at`",
+		cond.ApplyFieldTable("o").(query.DialectClauseRenderer).RenderDialect(query.MySQL.WithQuoting(query.QuoteAlways), 0))
+}
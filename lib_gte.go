@@ -1,7 +1,6 @@
 package query
 
 import (
-	"strconv"
 	"strings"
 )
 
@@ -39,27 +38,28 @@ func (impl greaterOrEqual) Negate(newNegateIndicator bool) Condition {
 	return impl
 }
 
-// Render renders SQL SELECT clause part for current field.
-func (impl greaterOrEqual) Render(paramNum int) string {
+// RenderDialect renders SQL SELECT clause part for current field.
+// Renders parameters substitutions using specified Dialect placeholders.
+// Implements DialectClauseRenderer.
+func (impl greaterOrEqual) RenderDialect(dialect Dialect, paramNum int) string {
 	var tokens []string
 	if impl.IsNegate() {
-		tokens = []string{impl.RenderNegate() + " ", impl.RenderSpec(), ">=$" + strconv.Itoa(paramNum+1)}
+		tokens = []string{impl.RenderNegate() + " ", impl.renderOperandFor(dialect), ">=" + dialect.Placeholder(paramNum+1)}
 	} else {
-		tokens = []string{impl.RenderSpec(), ">=$" + strconv.Itoa(paramNum+1)}
+		tokens = []string{impl.renderOperandFor(dialect), ">=" + dialect.Placeholder(paramNum+1)}
 	}
 	return strings.Join(tokens, "")
 }
 
+// Render renders SQL SELECT clause part for current field.
+func (impl greaterOrEqual) Render(paramNum int) string {
+	return impl.RenderDialect(PostgreSQL, paramNum)
+}
+
 // RenderSQL renders SQL clause or its part.
 // Implementation should render parameters substitutions using standard sql "?"(question) character.
 func (impl greaterOrEqual) RenderSQL() (sql string) {
-	var tokens []string
-	if impl.IsNegate() {
-		tokens = []string{impl.RenderNegate() + " ", impl.RenderSpec(), ">=?"}
-	} else {
-		tokens = []string{impl.RenderSpec(), ">=?"}
-	}
-	return strings.Join(tokens, "")
+	return impl.RenderDialect(rawDialect, 0)
 }
 
 // And generates new condition which true on all conditions met.
@@ -76,9 +76,16 @@ func (impl greaterOrEqual) Or(conditions ...Condition) Condition {
 
 // GreaterOrEqual generates Condition for any field type matching rows
 // having field values greater or equal to specified value.
+// Use GreaterOrEqualExpr to match expressions such as Sum or Lower.
 func GreaterOrEqual(fieldName FieldName, value interface{}) Condition {
+	return GreaterOrEqualExpr(fieldName, value)
+}
+
+// GreaterOrEqualExpr is a generic form of GreaterOrEqual taking string, FieldName or FieldDefinition
+// including expressions such as Sum or Lower as field.
+func GreaterOrEqualExpr[T FieldNameParameter](field T, value interface{}) Condition {
 	return &greaterOrEqual{
 		BaseCondition: *newBaseCondition(LogicalAND, false),
-		FieldValue:    *NewFieldValue(fieldName, value),
+		FieldValue:    FieldValue{FieldDefinition: Field(field), value: value},
 	}
 }
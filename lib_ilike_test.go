@@ -72,3 +72,44 @@ func Test_iContains_RenderSQL(t *testing.T) {
 		})
 	}
 }
+
+func Test_iLike_RenderDialect(t *testing.T) {
+	tests := []struct {
+		name    string
+		cond    query.Condition
+		dialect query.Dialect
+		want    string
+		values  []interface{}
+	}{
+		{"contains_postgres",
+			query.IContains("f1", "a%"),
+			query.PostgreSQL, `f1 ILIKE $1 ESCAPE '!'`, []interface{}{`%a!%%`},
+		},
+		{"contains_mysql",
+			query.IContains("f1", "abc"),
+			query.MySQL, "LOWER(f1) LIKE LOWER(?)", []interface{}{"%abc%"},
+		},
+		{"not_starts_with_oracle",
+			query.Not(query.IStartsWith("f1", "abc")),
+			query.Oracle, "LOWER(f1) NOT LIKE LOWER(:1)", []interface{}{"abc%"},
+		},
+		{"starts_with_postgres",
+			query.IStartsWith("f1", "abc"),
+			query.PostgreSQL, "f1 ILIKE $1", []interface{}{"abc%"},
+		},
+		{"ends_with_sqlite",
+			query.IEndsWith("f1", "a_b"),
+			query.SQLite, `LOWER(f1) LIKE LOWER(?) ESCAPE '!'`, []interface{}{`%a!_b`},
+		},
+		{"raw_pattern_sql_server",
+			query.ILike(query.Table("t").Field("f1"), "a%b"),
+			query.SQLServer, "LOWER(t.f1) LIKE LOWER(@p1)", []interface{}{"a%b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.cond.(query.DialectClauseRenderer).RenderDialect(tt.dialect, 0))
+			require.Equal(t, tt.values, tt.cond.Values())
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package query
 
 import (
-	"strconv"
 	"strings"
 )
 
@@ -42,47 +41,63 @@ func (impl in) Negate(newNegateIndicator bool) Condition {
 	return impl
 }
 
-// Render renders SQL SELECT clause part for current field.
-func (impl in) Render(paramNum int) string {
-	var tokens []string
+// RenderDialect renders SQL SELECT clause part for current field.
+// Renders parameters substitutions using specified Dialect placeholders.
+// Implements DialectClauseRenderer.
+// Subquery value is rendered continuing parameters numbering.
+func (impl in) RenderDialect(dialect Dialect, paramNum int) string {
+	var (
+		tokens             []string
+		placeholdersString string
+	)
+
+	if subQuery, isSubQuery := impl.value.(SubQuery); isSubQuery {
+		placeholdersString = "(" + subQuery.RenderDialect(dialect, paramNum) + ")"
+	} else {
+		placeholders := make([]string, len(impl.Values()))
 
-	placeholders := make([]string, len(impl.Values()))
+		for idx := range impl.Values() {
+			placeholders[idx] = dialect.Placeholder(paramNum + idx + 1)
+		}
 
-	for idx := range impl.Values() {
-		placeholders[idx] = "$" + strconv.Itoa(paramNum+idx+1)
+		placeholdersString = "(" + strings.Join(placeholders, ",") + ")"
 	}
 
-	placeholdersString := "(" + strings.Join(placeholders, ",") + ")"
-
 	if impl.IsNegate() {
-		tokens = []string{impl.RenderSpec(), impl.RenderNegate(), inSymbol, placeholdersString}
+		tokens = []string{impl.renderOperandFor(dialect), impl.RenderNegate(), inSymbol, placeholdersString}
 	} else {
-		tokens = []string{impl.RenderSpec(), inSymbol, placeholdersString}
+		tokens = []string{impl.renderOperandFor(dialect), inSymbol, placeholdersString}
 	}
 
 	return strings.Join(tokens, " ")
 }
 
-// RenderSQL renders SQL clause or its part.
-// Implementation should render parameters substitutions using standard sql "?"(question) character.
-func (impl in) RenderSQL() (sql string) {
-	var tokens []string
-
-	placeholders := make([]string, len(impl.Values()))
+// Values returns a set of values to substitute, either list values or subquery parameters.
+// Subquery parameters are ordered as PostgreSQL expects, use ValuesFor to get them for another Dialect.
+// Implements ValuesProvider.
+func (impl in) Values() []any {
+	return impl.ValuesFor(PostgreSQL)
+}
 
-	for idx := range impl.Values() {
-		placeholders[idx] = "?"
+// ValuesFor returns a set of values to substitute, either list values or subquery parameters
+// ordered as query rendered with specified Dialect expects. Implements DialectValuesProvider.
+func (impl in) ValuesFor(dialect Dialect) []any {
+	if subQuery, isSubQuery := impl.value.(SubQuery); isSubQuery {
+		return subQuery.ValuesFor(dialect)
 	}
 
-	placeholdersString := "(" + strings.Join(placeholders, ",") + ")"
+	return impl.FieldValue.Values()
+}
 
-	if impl.IsNegate() {
-		tokens = []string{impl.RenderSpec(), impl.RenderNegate(), inSymbol, placeholdersString}
-	} else {
-		tokens = []string{impl.RenderSpec(), inSymbol, placeholdersString}
-	}
+// Render renders SQL SELECT clause part for current field.
+func (impl in) Render(paramNum int) string {
+	return impl.RenderDialect(PostgreSQL, paramNum)
+}
 
-	return strings.Join(tokens, " ")
+// RenderSQL renders SQL clause or its part.
+// Implementation should render parameters substitutions using standard sql "?"(question) character.
+func (impl in) RenderSQL() (sql string) {
+	return impl.RenderDialect(rawDialect, 0)
 }
 
 // And generates new condition which true on all conditions met.
@@ -98,9 +113,18 @@ func (impl in) Or(conditions ...Condition) Condition {
 }
 
 // In generates Condition for any field type to match records having field values in than specified.
+// Value could be a slice of values or SubQuery such as BaseSelectBuilder selecting single field,
+// subquery parameters are numbered after parameters of preceding conditions.
+// Use InExpr to match expressions such as Sum or Lower.
 func In(fieldName FieldName, value interface{}) Condition {
+	return InExpr(fieldName, value)
+}
+
+// InExpr is a generic form of In taking string, FieldName or FieldDefinition
+// including expressions such as Sum or Lower as field.
+func InExpr[T FieldNameParameter](field T, value interface{}) Condition {
 	return &in{
 		BaseCondition: *newBaseCondition(LogicalAND, false),
-		FieldValue:    *NewFieldValue(fieldName, value),
+		FieldValue:    FieldValue{FieldDefinition: Field(field), value: value},
 	}
 }
@@ -40,6 +40,16 @@ func Test_In_Render(t *testing.T) {
 			query.Not(query.In("f1", []string{"123", "321"})),
 			2, "f1 NOT IN ($3,$4)", []interface{}{"123", "321"},
 		},
+		{"in_subquery",
+			query.In("customer_id", query.SelectFrom("customers").
+				Fields(query.Field("id")).
+				Where(query.EqualTo("country", "NL"), query.GreaterThan("score", 5))),
+			2, "customer_id IN (SELECT id FROM customers WHERE country=$3 AND score>$4)", []interface{}{"NL", 5},
+		},
+		{"not_in_subquery",
+			query.Not(query.In("customer_id", query.SelectFrom("banned").Fields(query.Field("customer_id")))),
+			0, "customer_id NOT IN (SELECT customer_id FROM banned)", []interface{}{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -144,3 +154,42 @@ func Test_In_RenderSQL(t *testing.T) {
 		})
 	}
 }
+
+func Test_In_PaginatedSubQuery(t *testing.T) {
+	recent := query.SelectManyFrom("payments").
+		Fields(query.Field("order_id")).
+		Where(query.EqualTo("x", 7)).
+		OrderBy(query.ASC("id")).
+		Offset(5).
+		Limit(10)
+
+	tests := []struct {
+		name    string
+		dialect query.Dialect
+		want    string
+		params  []any
+	}{
+		{"postgresql", query.PostgreSQL,
+			"SELECT * FROM orders WHERE status=$1 AND id IN " +
+				"(SELECT order_id FROM payments WHERE x=$2 ORDER BY id ASC OFFSET $3 LIMIT $4)",
+			[]any{"new", 7, uint(5), uint(10)}},
+		{"mysql", query.MySQL,
+			"SELECT * FROM orders WHERE status=? AND id IN " +
+				"(SELECT order_id FROM payments WHERE x=? ORDER BY id ASC LIMIT ? OFFSET ?)",
+			[]any{"new", 7, uint(10), uint(5)}},
+		{"sqlite", query.SQLite,
+			"SELECT * FROM orders WHERE status=? AND id IN " +
+				"(SELECT order_id FROM payments WHERE x=? ORDER BY id ASC LIMIT ? OFFSET ?)",
+			[]any{"new", 7, uint(10), uint(5)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, params, err := query.SelectFrom("orders").
+				Where(query.EqualTo("status", "new"), query.In("id", recent)).
+				BuildQueryAndParamsFor(tt.dialect)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, sql)
+			require.Equal(t, tt.params, params)
+		})
+	}
+}
@@ -39,19 +39,26 @@ func (impl nullValue) Negate(newNegateIndicator bool) Condition {
 	return impl
 }
 
+// RenderDialect renders IsNULL condition clause. No parameters substitutions are used.
+// Implements DialectClauseRenderer.
+func (impl nullValue) RenderDialect(dialect Dialect, _ int) string {
+	var tokens []string
+	if impl.IsNegate() {
+		tokens = []string{impl.renderOperandFor(dialect), "IS", "NOT", "NULL"}
+	} else {
+		tokens = []string{impl.renderOperandFor(dialect), "IS", "NULL"}
+	}
+	return strings.Join(tokens, " ")
+}
+
 // Render renders IsNULL condition clause.
 func (impl nullValue) Render(_ int) string {
 	return impl.RenderSQL()
 }
 
+// RenderSQL renders IsNULL condition clause.
 func (impl nullValue) RenderSQL() (sql string) {
-	var tokens []string
-	if impl.IsNegate() {
-		tokens = []string{impl.RenderSpec(), "IS", "NOT", "NULL"}
-	} else {
-		tokens = []string{impl.RenderSpec(), "IS", "NULL"}
-	}
-	return strings.Join(tokens, " ")
+	return impl.RenderDialect(rawDialect, 0)
 }
 
 // Values returns empty []interface{} slice as no substitutions required.
@@ -72,9 +79,16 @@ func (impl nullValue) Or(conditions ...Condition) Condition {
 }
 
 // IsNull generates Condition to select rows where specified field name value IS NULL'.
+// Use IsNullExpr to match expressions such as Sum or Lower.
 func IsNull(fieldName FieldName) Condition {
+	return IsNullExpr(fieldName)
+}
+
+// IsNullExpr is a generic form of IsNull taking string, FieldName or FieldDefinition
+// including expressions such as Sum or Lower as field.
+func IsNullExpr[T FieldNameParameter](field T) Condition {
 	return &nullValue{
 		BaseCondition:   *newBaseCondition(LogicalAND, false),
-		FieldDefinition: Field(fieldName),
+		FieldDefinition: Field(field),
 	}
 }
@@ -1,32 +1,48 @@
 package query
 
 import (
-	"strconv"
 	"strings"
 )
 
-// contains implements string fields compare using LIKE operator.
-// It adds <field_name> LIKE '%<value>%' to SQL SELECT clause.
-type contains struct {
+// likeEscape is escape character used to match LIKE wildcards literally.
+// Backslash is not used as it is string literals escape character for MySQL unless NO_BACKSLASH_ESCAPES is set.
+const likeEscape = "!"
+
+var (
+	// likeEscaper escapes LIKE wildcards and escape character itself.
+	likeEscaper = strings.NewReplacer(likeEscape, likeEscape+likeEscape, "%", likeEscape+"%", "_", likeEscape+"_")
+
+	// sqlServerLikeEscaper escapes LIKE wildcards including SQL Server character class opening bracket.
+	sqlServerLikeEscaper = strings.NewReplacer(
+		likeEscape, likeEscape+likeEscape, "%", likeEscape+"%", "_", likeEscape+"_", "[", likeEscape+"[")
+)
+
+// like implements string fields compare using LIKE or ILIKE operator.
+// It adds <field_name> LIKE '<prefix><value><suffix>' to SQL SELECT clause.
+type like struct {
 	BaseCondition
 	FieldValue
+	prefix      string // wildcard prepended to value
+	suffix      string // wildcard appended to value
+	escaped     bool   // value wildcards should be escaped to match them literally
+	insensitive bool   // case-independent comparison
 }
 
 // ApplyFieldTable makes a copy of contains condition with updated FieldDefinition table name.
 // Implements Condition.
-func (impl contains) ApplyFieldTable(table TableName) Condition {
+func (impl like) ApplyFieldTable(table TableName) Condition {
 	impl.FieldValue = impl.FieldValue.ApplyFieldTable(table)
 	return impl
 }
 
 // Join returns a copy of Group having JoinType set to specified value.
-func (impl contains) Join(newJoinType JoinType) Condition {
+func (impl like) Join(newJoinType JoinType) Condition {
 	impl.BaseCondition = impl.BaseCondition.Join(newJoinType)
 	return impl
 }
 
 // Negate returns a copy of BaseCondition having IsNegate set to specified value.
-func (impl contains) Negate(newNegateIndicator bool) Condition {
+func (impl like) Negate(newNegateIndicator bool) Condition {
 	impl.BaseCondition = impl.BaseCondition.Negate(newNegateIndicator)
 	return impl
 }
@@ -34,59 +50,132 @@ func (impl contains) Negate(newNegateIndicator bool) Condition {
 // ApplyFieldSpec makes a copy of Condition with updated FieldDefinition if fieldName match.
 // If FieldName is not matched it does nothing.
 // Implements Condition.
-func (impl contains) ApplyFieldSpec(spec FieldDefinition) Condition {
+func (impl like) ApplyFieldSpec(spec FieldDefinition) Condition {
 	impl.FieldValue = impl.FieldValue.ApplyFieldSpec(spec)
 	return impl
 }
 
 // And generates new condition which true on all conditions met.
 // Implements Condition.
-func (impl contains) And(conditions ...Condition) Condition {
+func (impl like) And(conditions ...Condition) Condition {
 	return NewGroup(LogicalAND, impl).And(conditions...)
 }
 
 // Or generates new condition group which true on either initial condition is true or all of additional are true.
 // Implements Condition.
-func (impl contains) Or(conditions ...Condition) Condition {
+func (impl like) Or(conditions ...Condition) Condition {
 	return NewGroup(LogicalOR, impl).Or(conditions...)
 }
 
-// Render renders SQL SELECT clause part for current mustField.
+// RenderDialect renders SQL SELECT clause part for current mustField.
 // Takes existed parameters count (0 means no parameters are defined yet).
-func (impl contains) Render(paramNum int) string {
-	var tokens []string
+// Renders parameters substitutions using specified Dialect placeholders.
+// Case-independent comparison uses ILIKE operator for PostgreSQL or LOWER(field) LIKE LOWER(value) otherwise.
+// ESCAPE clause is rendered only if value has wildcards escaped for specified Dialect.
+// Implements DialectClauseRenderer.
+func (impl like) RenderDialect(dialect Dialect, paramNum int) string {
+	operand, operator, placeholder := impl.renderOperandFor(dialect), "LIKE", dialect.Placeholder(paramNum+1)
+
+	switch {
+	case impl.insensitive && dialect.kind == kindPostgreSQL:
+		operator = opIlike
+	case impl.insensitive:
+		operand, placeholder = "LOWER("+operand+")", "LOWER("+placeholder+")"
+	}
+
+	tokens := []string{operand, operator, placeholder}
 	if impl.IsNegate() {
-		tokens = []string{impl.RenderSpec(), impl.RenderNegate(), "LIKE", "$" + strconv.Itoa(paramNum+1)}
-	} else {
-		tokens = []string{impl.RenderSpec(), "LIKE", "$" + strconv.Itoa(paramNum+1)}
+		tokens = []string{operand, impl.RenderNegate(), operator, placeholder}
+	}
+
+	if value := impl.value.(string); impl.escapeFor(dialect, value) != value {
+		tokens = append(tokens, "ESCAPE", quoteLiteral(dialect, likeEscape))
 	}
 
 	return strings.Join(tokens, " ")
 }
 
+// Render renders SQL SELECT clause part for current mustField.
+// Takes existed parameters count (0 means no parameters are defined yet).
+func (impl like) Render(paramNum int) string {
+	return impl.RenderDialect(PostgreSQL, paramNum)
+}
+
 // RenderSQL renders SQL clause or its part.
 // Implementation should render parameters substitutions using standard sql "?"(question) character.
-func (impl contains) RenderSQL() (sql string) {
-	var tokens []string
-	if impl.IsNegate() {
-		tokens = []string{impl.RenderSpec(), impl.RenderNegate(), "LIKE", "?"}
-	} else {
-		tokens = []string{impl.RenderSpec(), "LIKE", "?"}
-	}
+func (impl like) RenderSQL() (sql string) {
+	return impl.RenderDialect(rawDialect, 0)
+}
 
-	return strings.Join(tokens, " ")
+// Values provides single string value wrapped to wildcards to fill SQL LIKE clause.
+// Value wildcards are escaped as PostgreSQL expects, use ValuesFor to get value escaped for another Dialect.
+func (impl like) Values() []interface{} {
+	return impl.ValuesFor(PostgreSQL)
 }
 
-// Values provides single string value wrapped to percent sign to fill SQL LIKE clause.
-func (impl contains) Values() []interface{} {
-	return []interface{}{"%" + (impl.value).(string) + "%"}
+// ValuesFor provides single string value having wildcards escaped for specified Dialect and wrapped to wildcards.
+// SQL Server requires [ character escaping in addition to % and _ ones.
+// Implements DialectValuesProvider.
+func (impl like) ValuesFor(dialect Dialect) []any {
+	return []any{impl.prefix + impl.escapeFor(dialect, impl.value.(string)) + impl.suffix}
+}
+
+// escapeFor returns value having wildcards escaped for specified Dialect if escaping required.
+func (impl like) escapeFor(dialect Dialect, value string) string {
+	switch {
+	case !impl.escaped:
+		return value
+	case dialect.kind == kindSQLServer:
+		return sqlServerLikeEscaper.Replace(value)
+	default:
+		return likeEscaper.Replace(value)
+	}
+}
+
+// newLike makes LIKE condition matching value wrapped into specified wildcards.
+// Value wildcards are escaped unless raw pattern is requested by empty prefix and suffix.
+func newLike[T FieldNameParameter](field T, prefix string, value string, suffix string, insensitive bool) Condition {
+	return &like{
+		BaseCondition: *newBaseCondition(LogicalAND, false),
+		FieldValue:    FieldValue{FieldDefinition: Field(field), value: value},
+		prefix:        prefix,
+		suffix:        suffix,
+		escaped:       len(prefix) > 0 || len(suffix) > 0,
+		insensitive:   insensitive,
+	}
 }
 
 // Contains generates Condition to compare string like fields using LIKE '%value%'.
+// Value wildcards % and _ (as well as [ for SQL Server) are escaped to match them literally.
 // See IContains condition generator to make case-independent `contains` comparison.
+// Use ContainsExpr to match expressions such as Sum or Lower.
 func Contains(fieldName FieldName, value string) Condition {
-	return &contains{
-		BaseCondition: *newBaseCondition(LogicalAND, false),
-		FieldValue:    *NewFieldValue(fieldName, value),
-	}
+	return ContainsExpr(fieldName, value)
+}
+
+// ContainsExpr is a generic form of Contains taking string, FieldName or FieldDefinition
+// including expressions such as Sum or Lower as field.
+func ContainsExpr[T FieldNameParameter](field T, value string) Condition {
+	return newLike(field, "%", value, "%", false)
+}
+
+// StartsWith generates Condition to match string like fields starting with value using LIKE 'value%'.
+// Value wildcards % and _ are escaped to match them literally. Prefix search could use field index.
+// See IStartsWith condition generator to make case-independent comparison.
+func StartsWith[T FieldNameParameter](field T, value string) Condition {
+	return newLike(field, "", value, "%", false)
+}
+
+// EndsWith generates Condition to match string like fields ending with value using LIKE '%value'.
+// Value wildcards % and _ are escaped to match them literally.
+// See IEndsWith condition generator to make case-independent comparison.
+func EndsWith[T FieldNameParameter](field T, value string) Condition {
+	return newLike(field, "%", value, "", false)
+}
+
+// Like generates Condition to match string like fields using LIKE 'pattern'.
+// Pattern is used as is, so % and _ wildcards could be used in it. Never take pattern from insecure environment,
+// use Contains, StartsWith or EndsWith to match user supplied values.
+func Like[T FieldNameParameter](field T, pattern string) Condition {
+	return newLike(field, "", pattern, "", false)
 }
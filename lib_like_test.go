@@ -72,3 +72,65 @@ func Test_contains_RenderSQL(t *testing.T) {
 		})
 	}
 }
+
+func Test_like_RenderDialect(t *testing.T) {
+	tests := []struct {
+		name    string
+		cond    query.Condition
+		dialect query.Dialect
+		want    string
+		values  []interface{}
+	}{
+		{"contains_escaped",
+			query.Contains("f1", `50%_a\b`),
+			query.PostgreSQL, `f1 LIKE $1 ESCAPE '!'`, []interface{}{`%50!%!_a\b%`},
+		},
+		{"contains_escaped_mysql",
+			query.Not(query.Contains("f1", "a_b")),
+			query.MySQL, `f1 NOT LIKE ? ESCAPE '!'`, []interface{}{`%a!_b%`},
+		},
+		{"contains_escape_char",
+			query.Contains("f1", "wow!"),
+			query.MySQL, `f1 LIKE ? ESCAPE '!'`, []interface{}{`%wow!!%`},
+		},
+		{"contains_bracket",
+			query.Contains("f1", "[a]"),
+			query.PostgreSQL, "f1 LIKE $1", []interface{}{"%[a]%"},
+		},
+		{"contains_bracket_sql_server",
+			query.Contains("f1", "[a]"),
+			query.SQLServer, `f1 LIKE @p1 ESCAPE '!'`, []interface{}{"%![a]%"},
+		},
+		{"starts_with",
+			query.StartsWith("f1", "abc"),
+			query.PostgreSQL, "f1 LIKE $1", []interface{}{"abc%"},
+		},
+		{"starts_with_escaped",
+			query.StartsWith("f1", "100%"),
+			query.SQLServer, `f1 LIKE @p1 ESCAPE '!'`, []interface{}{`100!%%`},
+		},
+		{"ends_with",
+			query.EndsWith("f1", ".txt"),
+			query.SQLite, "f1 LIKE ?", []interface{}{"%.txt"},
+		},
+		{"raw_pattern",
+			query.Like("f1", "a_c%"),
+			query.Oracle, "f1 LIKE :1", []interface{}{"a_c%"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.cond.(query.DialectClauseRenderer).RenderDialect(tt.dialect, 0))
+			require.Equal(t, tt.values, tt.cond.(query.DialectValuesProvider).ValuesFor(tt.dialect))
+		})
+	}
+}
+
+func Test_like_SelectSQLServer(t *testing.T) {
+	sql, params, err := query.SelectFrom("files").
+		Where(query.StartsWith("name", "[draft]_")).
+		BuildQueryAndParamsFor(query.SQLServer)
+	require.NoError(t, err)
+	require.Equal(t, `SELECT * FROM files WHERE name LIKE @p1 ESCAPE '!'`, sql)
+	require.Equal(t, []any{"![draft]!_%"}, params)
+}
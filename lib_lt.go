@@ -1,7 +1,6 @@
 package query
 
 import (
-	"strconv"
 	"strings"
 )
 
@@ -42,27 +41,28 @@ func (impl less) Negate(newNegateIndicator bool) Condition {
 	return impl
 }
 
-// Render renders SQL SELECT clause part for current field.
-func (impl less) Render(paramNum int) string {
+// RenderDialect renders SQL SELECT clause part for current field.
+// Renders parameters substitutions using specified Dialect placeholders.
+// Implements DialectClauseRenderer.
+func (impl less) RenderDialect(dialect Dialect, paramNum int) string {
 	var tokens []string
 	if impl.IsNegate() {
-		tokens = []string{impl.RenderNegate() + " ", impl.RenderSpec(), lessSymbol, "$" + strconv.Itoa(paramNum+1)}
+		tokens = []string{impl.RenderNegate() + " ", impl.renderOperandFor(dialect), lessSymbol, dialect.Placeholder(paramNum + 1)}
 	} else {
-		tokens = []string{impl.RenderSpec(), lessSymbol, "$" + strconv.Itoa(paramNum+1)}
+		tokens = []string{impl.renderOperandFor(dialect), lessSymbol, dialect.Placeholder(paramNum + 1)}
 	}
 	return strings.Join(tokens, "")
 }
 
+// Render renders SQL SELECT clause part for current field.
+func (impl less) Render(paramNum int) string {
+	return impl.RenderDialect(PostgreSQL, paramNum)
+}
+
 // RenderSQL renders SQL clause or its part.
 // Implementation should render parameters substitutions using standard sql "?"(question) character.
 func (impl less) RenderSQL() (sql string) {
-	var tokens []string
-	if impl.IsNegate() {
-		tokens = []string{impl.RenderNegate() + " ", impl.RenderSpec(), lessSymbol, "?"}
-	} else {
-		tokens = []string{impl.RenderSpec(), lessSymbol, "?"}
-	}
-	return strings.Join(tokens, "")
+	return impl.RenderDialect(rawDialect, 0)
 }
 
 // And generates new condition which true on all conditions met.
@@ -78,9 +78,16 @@ func (impl less) Or(conditions ...Condition) Condition {
 }
 
 // Less generates Condition for any field type to match records having field values less than specified.
+// Use LessExpr to match expressions such as Sum or Lower.
 func Less(fieldName FieldName, value interface{}) Condition {
+	return LessExpr(fieldName, value)
+}
+
+// LessExpr is a generic form of Less taking string, FieldName or FieldDefinition
+// including expressions such as Sum or Lower as field.
+func LessExpr[T FieldNameParameter](field T, value interface{}) Condition {
 	return &less{
 		BaseCondition: *newBaseCondition(LogicalAND, false),
-		FieldValue:    *NewFieldValue(fieldName, value),
+		FieldValue:    FieldValue{FieldDefinition: Field(field), value: value},
 	}
 }
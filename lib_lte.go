@@ -1,7 +1,6 @@
 package query
 
 import (
-	"strconv"
 	"strings"
 )
 
@@ -43,27 +42,28 @@ func (impl lessOrEqual) Negate(newNegateIndicator bool) Condition {
 	return impl
 }
 
-// Render renders SQL SELECT clause part for current field.
-func (impl lessOrEqual) Render(paramNum int) string {
+// RenderDialect renders SQL SELECT clause part for current field.
+// Renders parameters substitutions using specified Dialect placeholders.
+// Implements DialectClauseRenderer.
+func (impl lessOrEqual) RenderDialect(dialect Dialect, paramNum int) string {
 	var tokens []string
 	if impl.IsNegate() {
-		tokens = []string{impl.RenderNegate() + " ", impl.RenderSpec(), lteOp, "$" + strconv.Itoa(paramNum+1)}
+		tokens = []string{impl.RenderNegate() + " ", impl.renderOperandFor(dialect), lteOp, dialect.Placeholder(paramNum + 1)}
 	} else {
-		tokens = []string{impl.RenderSpec(), lteOp, "$" + strconv.Itoa(paramNum+1)}
+		tokens = []string{impl.renderOperandFor(dialect), lteOp, dialect.Placeholder(paramNum + 1)}
 	}
 	return strings.Join(tokens, "")
 }
 
+// Render renders SQL SELECT clause part for current field.
+func (impl lessOrEqual) Render(paramNum int) string {
+	return impl.RenderDialect(PostgreSQL, paramNum)
+}
+
 // RenderSQL renders SQL clause or its part.
 // Implementation should render parameters substitutions using standard sql "?"(question) character.
 func (impl lessOrEqual) RenderSQL() (sql string) {
-	var tokens []string
-	if impl.IsNegate() {
-		tokens = []string{impl.RenderNegate() + " ", impl.RenderSpec(), lteOp, "?"}
-	} else {
-		tokens = []string{impl.RenderSpec(), lteOp, "?"}
-	}
-	return strings.Join(tokens, "")
+	return impl.RenderDialect(rawDialect, 0)
 }
 
 // And generates new condition which true on all conditions met.
@@ -79,9 +79,16 @@ func (impl lessOrEqual) Or(conditions ...Condition) Condition {
 }
 
 // LessOrEqual generates Condition for any field type matching rows having field values less or equal to specified.
+// Use LessOrEqualExpr to match expressions such as Sum or Lower.
 func LessOrEqual(fieldName FieldName, value interface{}) Condition {
+	return LessOrEqualExpr(fieldName, value)
+}
+
+// LessOrEqualExpr is a generic form of LessOrEqual taking string, FieldName or FieldDefinition
+// including expressions such as Sum or Lower as field.
+func LessOrEqualExpr[T FieldNameParameter](field T, value interface{}) Condition {
 	return &lessOrEqual{
 		BaseCondition: *newBaseCondition(LogicalAND, false),
-		FieldValue:    *NewFieldValue(fieldName, value),
+		FieldValue:    FieldValue{FieldDefinition: Field(field), value: value},
 	}
 }
@@ -0,0 +1,116 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+)
+
+// lockStrength defines selected rows locking mode.
+type lockStrength int
+
+const (
+	lockNotSet         lockStrength = iota // no locking clause requested
+	lockForUpdate                          // FOR UPDATE
+	lockForNoKeyUpdate                     // FOR NO KEY UPDATE, PostgreSQL only
+	lockForShare                           // FOR SHARE
+)
+
+// String returns SQL representation of lockStrength value.
+// Implements fmt.Stringer.
+func (strength lockStrength) String() string {
+	switch strength {
+	case lockForUpdate:
+		return "FOR UPDATE"
+	case lockForNoKeyUpdate:
+		return "FOR NO KEY UPDATE"
+	case lockForShare:
+		return "FOR SHARE"
+	default:
+		return ""
+	}
+}
+
+// lockWait defines behaviour when selected rows are already locked.
+type lockWait int
+
+const (
+	lockWaitDefault lockWait = iota // wait until locked rows are released
+	lockNoWait                      // NOWAIT, fail immediately
+	lockSkipLocked                  // SKIP LOCKED, skip locked rows
+)
+
+// rowLock stores SQL SELECT rows locking clause settings.
+type rowLock struct {
+	strength lockStrength
+	wait     lockWait
+	tables   []TableName // tables to lock rows of, empty means all tables of query
+}
+
+// validate returns error if locking clause could not be rendered using specified Dialect.
+// Takes paginated flag set if query renders pagination clause as Oracle does not allow to lock paginated rows.
+func (lock rowLock) validate(dialect Dialect, paginated bool) error {
+	switch {
+	case lock.strength == lockNotSet && (lock.wait != lockWaitDefault || len(lock.tables) > 0):
+		return fmt.Errorf("%w: rows lock options set without lock mode", Error)
+	case lock.strength == lockNotSet:
+		return nil
+	case dialect.kind == kindSQLite || dialect.kind == kindSQLServer:
+		return fmt.Errorf("%w: %v dialect does not support rows locking clause", Error, dialect)
+	case lock.strength == lockForNoKeyUpdate && dialect.kind != kindPostgreSQL:
+		return fmt.Errorf("%w: %v dialect does not support %v", Error, dialect, lock.strength)
+	case lock.strength == lockForShare && dialect.kind == kindOracle:
+		return fmt.Errorf("%w: %v dialect does not support %v", Error, dialect, lock.strength)
+	case len(lock.tables) > 0 && dialect.kind == kindOracle:
+		return fmt.Errorf("%w: %v dialect does not support locked tables list", Error, dialect)
+	case paginated && dialect.kind == kindOracle:
+		return fmt.Errorf("%w: %v dialect does not support rows locking with offset or limit", Error, dialect)
+	}
+
+	return nil
+}
+
+// renderFor renders rows locking clause using specified Dialect quoting mode.
+// Returns empty string if no locking requested.
+func (lock rowLock) renderFor(dialect Dialect) string {
+	if lock.strength == lockNotSet {
+		return ""
+	}
+
+	tokens := []string{lock.strength.String()}
+
+	if len(lock.tables) > 0 {
+		tables := make([]string, len(lock.tables))
+		for idx, table := range lock.tables {
+			tables[idx] = dialect.QuoteIdent(string(table))
+		}
+
+		tokens = append(tokens, "OF", strings.Join(tables, ", "))
+	}
+
+	switch lock.wait {
+	case lockNoWait:
+		tokens = append(tokens, "NOWAIT")
+	case lockSkipLocked:
+		tokens = append(tokens, "SKIP LOCKED")
+	}
+
+	return strings.Join(tokens, " ")
+}
+
+// withStrength returns a copy of rowLock having lock mode set to specified value.
+func (lock rowLock) withStrength(strength lockStrength) rowLock {
+	lock.strength = strength
+	return lock
+}
+
+// withWait returns a copy of rowLock having locked rows behaviour set to specified value.
+func (lock rowLock) withWait(wait lockWait) rowLock {
+	lock.wait = wait
+	return lock
+}
+
+// withTables returns a copy of rowLock having tables to lock set to specified list.
+func (lock rowLock) withTables(tables ...TableName) rowLock {
+	lock.tables = append(make([]TableName, 0, len(tables)), tables...)
+	return lock
+}
@@ -0,0 +1,88 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/query"
+)
+
+func TestSelectManyBuilder_ForUpdate(t *testing.T) {
+	jobs := query.SelectManyFrom("jobs").Where(query.EqualTo("status", "new")).Limit(10)
+
+	tests := []struct {
+		name       string
+		builder    query.SelectManyBuilder
+		dialect    query.Dialect
+		wantSQL    string
+		wantParams []any
+		wantErr    bool
+	}{
+		{
+			"postgres_skip_locked",
+			jobs.ForUpdate().SkipLocked(),
+			query.PostgreSQL,
+			"SELECT * FROM jobs WHERE status=$1 LIMIT $2 FOR UPDATE SKIP LOCKED",
+			[]any{"new", uint(10)},
+			false,
+		},
+		{
+			"postgres_no_key_update_of",
+			jobs.ForNoKeyUpdate().Of("jobs").NoWait(),
+			query.PostgreSQL,
+			"SELECT * FROM jobs WHERE status=$1 LIMIT $2 FOR NO KEY UPDATE OF jobs NOWAIT",
+			[]any{"new", uint(10)},
+			false,
+		},
+		{
+			"mysql_share_nowait",
+			jobs.ForShare().NoWait(),
+			query.MySQL,
+			"SELECT * FROM jobs WHERE status=? LIMIT ? FOR SHARE NOWAIT",
+			[]any{"new", uint(10)},
+			false,
+		},
+		{
+			"oracle_skip_locked",
+			jobs.Limit(-1).ForUpdate().SkipLocked(),
+			query.Oracle,
+			"SELECT * FROM jobs WHERE status=:1 FOR UPDATE SKIP LOCKED",
+			[]any{"new"},
+			false,
+		},
+		{"oracle_paginated_rejected", jobs.ForUpdate(), query.Oracle, "", nil, true},
+		{"sqlite_rejected", jobs.ForUpdate(), query.SQLite, "", nil, true},
+		{"sql_server_rejected", jobs.ForUpdate(), query.SQLServer, "", nil, true},
+		{"mysql_no_key_update_rejected", jobs.ForNoKeyUpdate(), query.MySQL, "", nil, true},
+		{"oracle_share_rejected", jobs.ForShare(), query.Oracle, "", nil, true},
+		{"oracle_of_rejected", jobs.ForUpdate().Of("jobs"), query.Oracle, "", nil, true},
+		{"options_without_mode_rejected", jobs.SkipLocked(), query.PostgreSQL, "", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, params, err := tt.builder.BuildQueryAndParamsFor(tt.dialect)
+			if tt.wantErr {
+				require.ErrorIs(t, err, query.Error)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSQL, sql)
+			require.Equal(t, tt.wantParams, params)
+		})
+	}
+}
+
+func TestSelectSingleBuilder_ForUpdate(t *testing.T) {
+	sql, params, err := query.SelectSingleFrom("accounts").
+		Where(query.EqualTo("id", 1)).
+		ForUpdate().
+		BuildQueryAndParams()
+	require.NoError(t, err)
+	require.Equal(t, "SELECT * FROM accounts WHERE id=$1 LIMIT 1 FOR UPDATE", sql)
+	require.Equal(t, []any{1}, params)
+
+	_, _, err = query.SelectSingleFrom("accounts").ForShare().BuildQueryAndParamsFor(query.SQLite)
+	require.ErrorIs(t, err, query.Error)
+}
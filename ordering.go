@@ -33,7 +33,12 @@ func (o FieldSorting) Direction() SortDirection {
 
 // Render makes an ORDER BY string.
 func (o FieldSorting) Render() string {
-	return o.FieldDefinition.RenderField() + " " + string(o.direction)
+	return o.RenderFor(PostgreSQL)
+}
+
+// RenderFor makes an ORDER BY string quoting field according to specified Dialect quoting mode.
+func (o FieldSorting) RenderFor(dialect Dialect) string {
+	return o.FieldDefinition.RenderFieldFor(dialect) + " " + string(o.direction)
 }
 
 // ApplyFieldSpec returns a copy of FieldSorting item with FieldDefinition updated if field name matches.
@@ -53,32 +58,60 @@ func (o FieldSorting) applyFieldTable(table TableName) FieldSorting {
 	return o
 }
 
-// ASC generates new FieldSorting to build ordering clause with Ascending order by specified field name.
+// ASC generates new FieldSorting to build ordering clause with Ascending order by specified field.
+// Use ASCExpr to order by expressions such as Sum or Lower.
 // Note invalid field name leads to panic.
 // Use FieldName.Validate before build ordering when taking field name from insecure environment.
 // To choose ordering direction programmatically use OrderBy constructor instead.
 func ASC(fieldName FieldName) FieldSorting {
-	if err := fieldName.Validate(); err != nil {
-		panic(fmt.Sprintf("invalid field name: %v", err))
-	}
+	return ASCExpr(fieldName)
+}
+
+// ASCExpr is a generic form of ASC taking string, FieldName or FieldDefinition
+// including expressions such as Sum or Lower as field.
+func ASCExpr[T FieldNameParameter](field T) FieldSorting {
 	return FieldSorting{
-		FieldDefinition: Field(fieldName),
+		FieldDefinition: sortingField(field),
 		direction:       Ascending,
 	}
 }
 
-// DESC generates new FieldSorting to build ordering clause with Descending order by specified field name.
+// DESC generates new FieldSorting to build ordering clause with Descending order by specified field.
+// Use DESCExpr to order by expressions such as Sum or Lower.
 // Use FieldName.Validate before build ordering when taking field name from insecure environment.
 func DESC(fieldName FieldName) FieldSorting {
-	if err := fieldName.Validate(); err != nil {
-		panic(fmt.Sprintf("invalid field name: %v", err))
-	}
+	return DESCExpr(fieldName)
+}
+
+// DESCExpr is a generic form of DESC taking string, FieldName or FieldDefinition
+// including expressions such as Sum or Lower as field.
+func DESCExpr[T FieldNameParameter](field T) FieldSorting {
 	return FieldSorting{
-		FieldDefinition: Field(fieldName),
+		FieldDefinition: sortingField(field),
 		direction:       Descending,
 	}
 }
 
+// sortingField makes FieldDefinition to sort by. Panics if string or FieldName argument is invalid field name.
+func sortingField[T FieldNameParameter](field T) FieldDefinition {
+	var param any = field
+
+	switch typed := param.(type) {
+	case FieldDefinition:
+		return typed
+	case FieldName:
+		if err := typed.Validate(); err != nil {
+			panic(fmt.Sprintf("invalid field name: %v", err))
+		}
+	case string:
+		if err := FieldName(typed).Validate(); err != nil {
+			panic(fmt.Sprintf("invalid field name: %v", err))
+		}
+	}
+
+	return Field(field)
+}
+
 // OrderBy creates FieldSorting instance using field name.
 // If optional direction specified it should be either Ascending or Descending, default is Ascending.
 // When ordering is known during development use ASC or DESC constructors instead.
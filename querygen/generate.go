@@ -0,0 +1,264 @@
+package querygen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"strings"
+	"unicode"
+
+	"github.com/amarin/query"
+)
+
+// commonInitialisms lists words rendered upper cased in Go identifiers.
+var commonInitialisms = map[string]bool{
+	"API": true, "HTML": true, "HTTP": true, "ID": true, "IP": true, "JSON": true,
+	"SQL": true, "URI": true, "URL": true, "UUID": true, "XML": true,
+}
+
+// reservedMethods lists table descriptor method names columns accessors should not override.
+var reservedMethods = map[string]bool{"As": true, "Ident": true, "Fields": true}
+
+// goTypes maps SQL types to Go types of non-nullable columns.
+var goTypes = map[string]string{
+	"BIGINT": "int64", "BIGSERIAL": "int64", "INT": "int64", "INT2": "int64", "INT4": "int64", "INT8": "int64",
+	"INTEGER": "int64", "MEDIUMINT": "int64", "SERIAL": "int64", "SMALLINT": "int64", "SMALLSERIAL": "int64",
+	"TINYINT": "int64",
+	"BOOL":    "bool", "BOOLEAN": "bool", "BIT": "bool", "TINYINT(1)": "bool",
+	"DOUBLE": "float64", "DOUBLE PRECISION": "float64", "FLOAT": "float64", "FLOAT4": "float64",
+	"FLOAT8": "float64", "REAL": "float64",
+	"DATE": "time.Time", "DATETIME": "time.Time", "DATETIME2": "time.Time", "TIME": "time.Time",
+	"TIMESTAMP": "time.Time", "TIMESTAMPTZ": "time.Time", "TIMESTAMP WITH TIME ZONE": "time.Time",
+	"TIMESTAMP WITHOUT TIME ZONE": "time.Time",
+	"BLOB":                        "[]byte", "BYTEA": "[]byte", "BINARY": "[]byte", "VARBINARY": "[]byte", "JSON": "[]byte",
+	"JSONB": "[]byte",
+}
+
+// goType returns Go type of struct field to scan column into.
+// Nullable columns are scanned into pointers, unknown types into any and text like types into string.
+// MySQL UNSIGNED integer columns are scanned into uint64, UNSIGNED modifier of other types is ignored.
+func goType(column Column) string {
+	sqlType, unsigned := strings.CutSuffix(column.Type, " UNSIGNED")
+	typeName, found := goTypes[sqlType]
+
+	switch {
+	case strings.HasSuffix(column.Type, "[]"):
+		return "any"
+	case !found:
+		typeName = "string" // CHAR, VARCHAR, TEXT, UUID, NUMERIC and other types having text representation
+	case unsigned && typeName == "int64":
+		typeName = "uint64"
+	}
+
+	if column.Nullable && typeName != "[]byte" {
+		return "*" + typeName
+	}
+
+	return typeName
+}
+
+// goName converts SQL identifier into exported Go identifier, i.e. user_id to UserID.
+func goName(name string) string {
+	words := strings.FieldsFunc(name, func(char rune) bool {
+		return !unicode.IsLetter(char) && !unicode.IsDigit(char)
+	})
+
+	builder := strings.Builder{}
+
+	for _, word := range words {
+		if upper := strings.ToUpper(word); commonInitialisms[upper] {
+			builder.WriteString(upper)
+			continue
+		}
+
+		runes := []rune(word)
+		builder.WriteString(strings.ToUpper(string(runes[0])) + string(runes[1:]))
+	}
+
+	result := builder.String()
+	if len(result) == 0 || unicode.IsDigit([]rune(result)[0]) {
+		result = "X" + result
+	}
+
+	return result
+}
+
+// Generate generates Go source file of specified package having typed descriptors of specified tables.
+// For every table it generates table descriptor type having query.TableIdent and column accessors
+// returning query.FieldDefinition qualified by table name or alias, column name constants such as UsersColumnID
+// and row struct having db tags to use with executor package or InsertBuilder.FromStruct.
+// Source names schema file in generated code header.
+// Returns error if table or column names are not supported by query package or produce the same Go names.
+func Generate(tables []Table, packageName string, source string) ([]byte, error) {
+	buffer := bytes.Buffer{}
+	usesTime := false
+
+	identifiers := make(map[string]string)
+
+	for _, table := range tables {
+		if err := declareTable(identifiers, table); err != nil {
+			return nil, err
+		}
+
+		for _, column := range table.Columns {
+			usesTime = usesTime || strings.HasSuffix(goType(column), "time.Time")
+		}
+	}
+
+	fmt.Fprintf(&buffer, "// Code generated by querygen from %v. DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(&buffer, "package %v\n\nimport (\n", packageName)
+
+	if usesTime {
+		fmt.Fprintf(&buffer, "\t\"time\"\n\n")
+	}
+
+	fmt.Fprintf(&buffer, "\t\"github.com/amarin/query\"\n)\n")
+
+	for _, table := range tables {
+		if err := generateTable(&buffer, table, tableGoName(table)); err != nil {
+			return nil, err
+		}
+	}
+
+	formatted, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%w: format generated code: %v", Error, err)
+	}
+
+	return formatted, nil
+}
+
+// tableGoName returns Go name of table descriptor variable, schema name is not used.
+func tableGoName(table Table) string {
+	return goName(table.Name[strings.LastIndex(table.Name, ".")+1:])
+}
+
+// declareTable registers package level identifiers generated for specified table mapped to their origins.
+// Returns error if any identifier is already generated for another table or column,
+// i.e. users_row table variable clashes with row struct type of users table.
+func declareTable(identifiers map[string]string, table Table) error {
+	name := tableGoName(table)
+	declared := [][2]string{
+		{name, "table " + table.Name + " variable"},
+		{name + "Table", "table " + table.Name + " type"},
+		{name + "TableName", "table " + table.Name + " name constant"},
+		{name + "Row", "table " + table.Name + " row type"},
+	}
+
+	for _, column := range table.Columns {
+		declared = append(declared, [2]string{
+			name + "Column" + goName(column.Name), "table " + table.Name + " column " + column.Name,
+		})
+	}
+
+	for _, item := range declared {
+		identifier, origin := item[0], item[1]
+		if existed, found := identifiers[identifier]; found {
+			return fmt.Errorf("%w: %v and %v produce the same Go name %v", Error, existed, origin, identifier)
+		}
+
+		identifiers[identifier] = origin
+	}
+
+	return nil
+}
+
+// generateTable writes single table descriptor, column constants and row struct.
+func generateTable(buffer *bytes.Buffer, table Table, name string) error {
+	tableType := name + "Table"
+	methods := make(map[string]string, len(table.Columns))
+	columnNames := make([]string, len(table.Columns))
+
+	if _, err := query.TableOrError(table.Name); err != nil {
+		return errors.Join(fmt.Errorf("%w: unsupported table name %v", Error, table.Name), err)
+	}
+
+	for idx, column := range table.Columns {
+		if err := query.FieldName(column.Name).Validate(); err != nil {
+			return errors.Join(fmt.Errorf("%w: unsupported column name %v of table %v", Error, column.Name, table.Name), err)
+		}
+
+		method := goName(column.Name)
+		if reservedMethods[method] {
+			method += "Column"
+		}
+
+		if existed, found := methods[method]; found {
+			return fmt.Errorf("%w: table %v columns %v and %v produce the same Go name %v",
+				Error, table.Name, existed, column.Name, method)
+		}
+
+		methods[method] = column.Name
+		columnNames[idx] = method
+	}
+
+	fmt.Fprintf(buffer, "\n// %v is %v table name.\nconst %v query.TableName = %q\n", tableType+"Name", table.Name,
+		tableType+"Name", table.Name)
+
+	fmt.Fprintf(buffer, "\n// %v columns names.\nconst (\n", table.Name)
+
+	for _, column := range table.Columns {
+		fmt.Fprintf(buffer, "\t%vColumn%v query.FieldName = %q\n", name, goName(column.Name), column.Name)
+	}
+
+	fmt.Fprintf(buffer, ")\n")
+
+	fmt.Fprintf(buffer, `
+// %[1]v describes %[2]v table. Use %[3]v variable to refer it.
+type %[1]v struct {
+	ident query.TableIdent
+}
+
+// %[3]v is %[2]v table descriptor.
+var %[3]v = %[1]v{ident: query.Table(%[1]vName)}
+
+// Ident returns query.TableIdent of %[2]v table to use in queries builders.
+func (table %[1]v) Ident() query.TableIdent {
+	return table.ident
+}
+
+// As returns a copy of %[1]v aliased with specified name, columns are qualified by alias then.
+func (table %[1]v) As(alias query.TableName) %[1]v {
+	table.ident = table.ident.As(alias)
+	return table
+}
+
+// Fields returns all %[2]v table columns qualified by table name or alias.
+func (table %[1]v) Fields() []query.FieldDefinition {
+	return []query.FieldDefinition{
+`, tableType, table.Name, name)
+
+	for idx := range table.Columns {
+		fmt.Fprintf(buffer, "\t\ttable.%v(),\n", columnNames[idx])
+	}
+
+	fmt.Fprintf(buffer, "\t}\n}\n")
+
+	for idx, column := range table.Columns {
+		fmt.Fprintf(buffer, `
+// %[1]v returns %[2]v column qualified by table name or alias.
+func (table %[3]v) %[1]v() query.FieldDefinition {
+	return table.ident.Field(%[4]v)
+}
+`, columnNames[idx], column.Name, tableType, name+"Column"+goName(column.Name))
+	}
+
+	fmt.Fprintf(buffer, "\n// %vRow is %v table row.\ntype %vRow struct {\n", name, table.Name, name)
+
+	fields := make([]string, len(table.Columns))
+
+	for idx, column := range table.Columns {
+		tag := column.Name
+		if column.PrimaryKey {
+			tag += ",pk"
+		}
+
+		fields[idx] = fmt.Sprintf("\t%v %v `db:%q`\n", goName(column.Name), goType(column), tag)
+	}
+
+	buffer.WriteString(strings.Join(fields, ""))
+	buffer.WriteString("}\n")
+
+	return nil
+}
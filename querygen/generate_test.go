@@ -0,0 +1,85 @@
+package querygen_test
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/query/querygen"
+)
+
+func TestGenerate(t *testing.T) {
+	tables, err := querygen.Parse(schema)
+	require.NoError(t, err)
+
+	code, err := querygen.Generate(tables, "models", "schema.sql")
+	require.NoError(t, err)
+
+	_, err = parser.ParseFile(token.NewFileSet(), "tables_gen.go", code, parser.AllErrors)
+	require.NoError(t, err)
+
+	for _, snippet := range []string{
+		"// Code generated by querygen from schema.sql. DO NOT EDIT.",
+		"package models",
+		`UsersTableName query.TableName = "users"`,
+		`UsersColumnCreatedAt query.FieldName = "created_at"`,
+		"var Users = UsersTable{ident: query.Table(UsersTableName)}",
+		"func (table UsersTable) CreatedAt() query.FieldDefinition {\n\treturn table.ident.Field(UsersColumnCreatedAt)",
+		"ID        int64     `db:\"id,pk\"`",
+		"Nick      *string   `db:\"nick\"`",
+		"Tags      any       `db:\"tags\"`",
+		`OrderItemsTableName query.TableName = "shop.order_items"`,
+		"func (table OrderItemsTable) FieldsColumn() query.FieldDefinition {",
+		"Fields  []byte `db:\"fields\"`",
+		"Enabled bool    `db:\"enabled\"`",
+		"Hits    *uint64 `db:\"hits\"`",
+		"Total   uint64  `db:\"total\"`",
+	} {
+		require.Contains(t, string(code), snippet)
+	}
+}
+
+func TestGenerate_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		tables []querygen.Table
+	}{
+		{
+			"unsupported_column",
+			[]querygen.Table{{Name: "users", Columns: []querygen.Column{{Name: "display name", Type: "TEXT"}}}},
+		},
+		{
+			"same_column_names",
+			[]querygen.Table{{Name: "users", Columns: []querygen.Column{{Name: "user_id"}, {Name: "user__id"}}}},
+		},
+		{
+			"table_variable_and_type",
+			[]querygen.Table{
+				{Name: "users", Columns: []querygen.Column{{Name: "id"}}},
+				{Name: "users_table", Columns: []querygen.Column{{Name: "id"}}},
+			},
+		},
+		{
+			"table_variable_and_column_constant",
+			[]querygen.Table{
+				{Name: "users", Columns: []querygen.Column{{Name: "id"}}},
+				{Name: "users_column_id", Columns: []querygen.Column{{Name: "id"}}},
+			},
+		},
+		{
+			"same_table_names",
+			[]querygen.Table{
+				{Name: "a.users", Columns: []querygen.Column{{Name: "id"}}},
+				{Name: "b.users", Columns: []querygen.Column{{Name: "id"}}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := querygen.Generate(tt.tables, "models", "schema.sql")
+			require.ErrorIs(t, err, querygen.Error)
+		})
+	}
+}
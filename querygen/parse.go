@@ -0,0 +1,323 @@
+// Package querygen generates typed table descriptors for query package from SQL DDL CREATE TABLE statements.
+// Use cmd/querygen command to run it with go generate.
+package querygen
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Error indicates schema parsing or code generation failed.
+var Error = errors.New("querygen")
+
+// Column describes single table column parsed from CREATE TABLE statement.
+type Column struct {
+	Name       string // column name as defined in schema
+	Type       string // upper cased SQL type without size, i.e. VARCHAR or DOUBLE PRECISION, except MySQL TINYINT(1)
+	Nullable   bool   // true unless NOT NULL or PRIMARY KEY constraint set
+	PrimaryKey bool   // true if column is a part of table primary key
+}
+
+// Table describes table parsed from CREATE TABLE statement.
+type Table struct {
+	Name    string // table name as defined in schema, could be schema qualified
+	Columns []Column
+}
+
+// Parse parses CREATE TABLE statements of SQL DDL script. Other statements are ignored.
+// Column and table level PRIMARY KEY constraints are recognized, other constraints are skipped.
+// Returns error if CREATE TABLE statement is malformed or table defined twice.
+func Parse(ddl string) (tables []Table, err error) {
+	var table Table
+
+	tokens := tokenize(ddl)
+	tables = make([]Table, 0)
+	names := make(map[string]struct{})
+
+	for pos := 0; pos < len(tokens); {
+		if !matchWords(tokens[pos:], "CREATE", "TABLE") {
+			pos++
+			continue
+		}
+
+		if table, pos, err = parseTable(tokens, pos+2); err != nil {
+			return nil, err
+		}
+
+		if _, found := names[table.Name]; found {
+			return nil, fmt.Errorf("%w: table %v defined twice", Error, table.Name)
+		}
+
+		names[table.Name] = struct{}{}
+		tables = append(tables, table)
+	}
+
+	return tables, nil
+}
+
+// token is a single DDL lexeme.
+type token struct {
+	text   string
+	quoted bool // quoted identifier, never treated as keyword
+}
+
+// is returns true if token is unquoted keyword matching specified word case-insensitively.
+func (t token) is(word string) bool {
+	return !t.quoted && strings.EqualFold(t.text, word)
+}
+
+// matchWords returns true if tokens start with specified keywords.
+func matchWords(tokens []token, words ...string) bool {
+	if len(tokens) < len(words) {
+		return false
+	}
+
+	for idx, word := range words {
+		if !tokens[idx].is(word) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// tokenize splits DDL script into words, quoted identifiers, string literals and punctuation skipping comments.
+func tokenize(ddl string) []token {
+	runes := []rune(ddl)
+	tokens := make([]token, 0)
+
+	for pos := 0; pos < len(runes); {
+		char := runes[pos]
+
+		switch {
+		case unicode.IsSpace(char):
+			pos++
+		case char == '-' && pos+1 < len(runes) && runes[pos+1] == '-':
+			for pos < len(runes) && runes[pos] != '\n' {
+				pos++
+			}
+		case char == '/' && pos+1 < len(runes) && runes[pos+1] == '*':
+			for pos += 2; pos < len(runes) && !(runes[pos-1] == '*' && runes[pos] == '/'); {
+				pos++
+			}
+
+			pos++
+		case char == '[' && pos+1 < len(runes) && runes[pos+1] == ']':
+			tokens = append(tokens, token{text: "[]"})
+			pos += 2
+		case char == '"' || char == '`' || char == '[' || char == '\'':
+			closing := map[rune]rune{'"': '"', '`': '`', '[': ']', '\'': '\''}[char]
+			end := pos + 1
+
+			for end < len(runes) && runes[end] != closing {
+				end++
+			}
+
+			if char == '\'' {
+				tokens = append(tokens, token{text: string(runes[pos:min(end+1, len(runes))])}) // keep literal quotes
+			} else {
+				tokens = append(tokens, token{text: string(runes[pos+1 : min(end, len(runes))]), quoted: true})
+			}
+
+			pos = end + 1
+		case unicode.IsLetter(char) || unicode.IsDigit(char) || char == '_' || char == '$':
+			end := pos
+			for end < len(runes) && (unicode.IsLetter(runes[end]) || unicode.IsDigit(runes[end]) ||
+				runes[end] == '_' || runes[end] == '$') {
+				end++
+			}
+
+			tokens = append(tokens, token{text: string(runes[pos:end])})
+			pos = end
+		default:
+			tokens = append(tokens, token{text: string(char)})
+			pos++
+		}
+	}
+
+	return tokens
+}
+
+// parseTable parses CREATE TABLE statement starting after CREATE TABLE keywords.
+// Returns parsed table and position following statement.
+func parseTable(tokens []token, pos int) (table Table, next int, err error) {
+	if matchWords(tokens[pos:], "IF", "NOT", "EXISTS") {
+		pos += 3
+	}
+
+	nameParts := make([]string, 0, 2)
+
+	for pos < len(tokens) && !tokens[pos].is("(") {
+		if !tokens[pos].is(".") {
+			nameParts = append(nameParts, tokens[pos].text)
+		}
+
+		pos++
+	}
+
+	if len(nameParts) == 0 || pos >= len(tokens) {
+		return table, pos, fmt.Errorf("%w: malformed CREATE TABLE statement", Error)
+	}
+
+	table.Name = strings.Join(nameParts, ".")
+
+	definitions, next := splitDefinitions(tokens, pos+1)
+	if next > len(tokens) {
+		return table, next, fmt.Errorf("%w: table %v definition is not closed", Error, table.Name)
+	}
+
+	primaryKey := make(map[string]struct{})
+
+	for _, definition := range definitions {
+		if matchWords(definition, "CONSTRAINT") && len(definition) > 2 {
+			definition = definition[2:] // named constraint, dispatch by keyword following name
+		}
+
+		switch {
+		case len(definition) == 0:
+			continue
+		case matchWords(definition, "PRIMARY", "KEY"):
+			for _, item := range definition[2:] {
+				if !item.is("(") && !item.is(")") && !item.is(",") {
+					primaryKey[item.text] = struct{}{}
+				}
+			}
+		case isConstraint(definition):
+			continue
+		default:
+			table.Columns = append(table.Columns, parseColumn(definition))
+		}
+	}
+
+	if len(table.Columns) == 0 {
+		return table, next, fmt.Errorf("%w: table %v defines no columns", Error, table.Name)
+	}
+
+	for idx, column := range table.Columns {
+		if _, found := primaryKey[column.Name]; found {
+			table.Columns[idx].PrimaryKey = true
+			table.Columns[idx].Nullable = false
+		}
+	}
+
+	return table, next, nil
+}
+
+// isConstraint returns true if table definition item starts with table constraint keyword.
+// INDEX and KEY start MySQL index definition only if followed by columns list or index name,
+// otherwise it is a column named index or key followed by its type.
+func isConstraint(definition []token) bool {
+	if len(definition) == 0 {
+		return false
+	}
+
+	for _, keyword := range []string{"UNIQUE", "FOREIGN", "CHECK", "EXCLUDE"} {
+		if definition[0].is(keyword) {
+			return true
+		}
+	}
+
+	if (!definition[0].is("INDEX") && !definition[0].is("KEY")) || len(definition) < 2 {
+		return false
+	}
+
+	if definition[1].is("(") {
+		return true
+	}
+
+	// index name is followed by USING index type or columns list while type could be followed by numeric size only
+	return len(definition) > 3 && (definition[2].is("USING") ||
+		(definition[2].is("(") && !unicode.IsDigit([]rune(definition[3].text)[0])))
+}
+
+// splitDefinitions splits table definition items separated by commas on top brackets level.
+// Takes position following opening bracket, returns position following closing bracket.
+func splitDefinitions(tokens []token, pos int) (definitions [][]token, next int) {
+	depth := 0
+	current := make([]token, 0)
+
+	for ; pos < len(tokens); pos++ {
+		switch {
+		case tokens[pos].is("("):
+			depth++
+		case tokens[pos].is(")") && depth == 0:
+			return append(definitions, current), pos + 1
+		case tokens[pos].is(")"):
+			depth--
+		case tokens[pos].is(",") && depth == 0:
+			definitions = append(definitions, current)
+			current = make([]token, 0)
+
+			continue
+		}
+
+		current = append(current, tokens[pos])
+	}
+
+	return definitions, len(tokens) + 1
+}
+
+// columnConstraints lists keywords finishing column type definition.
+var columnConstraints = []string{
+	"NOT", "NULL", "PRIMARY", "DEFAULT", "REFERENCES", "UNIQUE", "CHECK", "CONSTRAINT", "COLLATE",
+	"GENERATED", "AUTO_INCREMENT", "AUTOINCREMENT", "IDENTITY", "ON", "COMMENT",
+}
+
+// parseColumn parses column definition: name, type with optional size and constraints.
+func parseColumn(definition []token) Column {
+	column := Column{Name: definition[0].text, Nullable: true}
+	typeWords := make([]string, 0, 2)
+	pos := 1
+
+	for ; pos < len(definition); pos++ {
+		if definition[pos].is("(") {
+			if matchWords(definition[pos:], "(", "1", ")") && len(typeWords) == 1 && typeWords[0] == "TINYINT" {
+				typeWords[0] += "(1)" // MySQL boolean
+			}
+
+			for pos < len(definition) && !definition[pos].is(")") {
+				pos++ // skip type size
+			}
+
+			continue
+		}
+
+		if isColumnConstraint(definition[pos]) {
+			break
+		}
+
+		if definition[pos].is("[]") && len(typeWords) > 0 {
+			typeWords[len(typeWords)-1] += "[]"
+			continue
+		}
+
+		typeWords = append(typeWords, strings.ToUpper(definition[pos].text))
+	}
+
+	column.Type = strings.Join(typeWords, " ")
+
+	for ; pos < len(definition); pos++ {
+		switch {
+		case matchWords(definition[pos:], "NOT", "NULL"):
+			column.Nullable = false
+		case matchWords(definition[pos:], "PRIMARY", "KEY"):
+			column.PrimaryKey = true
+			column.Nullable = false
+		}
+	}
+
+	return column
+}
+
+// isColumnConstraint returns true if token starts column constraint.
+func isColumnConstraint(item token) bool {
+	for _, keyword := range columnConstraints {
+		if item.is(keyword) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,110 @@
+package querygen_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/query/querygen"
+)
+
+const schema = `
+-- accounts keep users
+CREATE TABLE IF NOT EXISTS "users" (
+	id BIGSERIAL PRIMARY KEY,
+	email VARCHAR(255) NOT NULL UNIQUE,
+	nick text DEFAULT 'NOT NULL, PRIMARY KEY',
+	created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+	tags text[],
+	balance NUMERIC(10, 2)
+);
+
+/* order lines, CREATE TABLE ignored (here) */
+CREATE TABLE shop.order_items (
+	order_id INTEGER NOT NULL REFERENCES orders(id),
+	line INT NOT NULL,
+	` + "`fields`" + ` JSONB,
+	PRIMARY KEY (order_id, line),
+	CONSTRAINT positive_line CHECK (line > 0)
+);
+
+CREATE INDEX users_email ON users(email);
+
+CREATE TABLE settings (
+	scope VARCHAR(32) NOT NULL,
+	key VARCHAR(64) NOT NULL,
+	index INT,
+	value TEXT,
+	enabled TINYINT(1) NOT NULL,
+	hits INT UNSIGNED,
+	total BIGINT(20) UNSIGNED NOT NULL,
+	CONSTRAINT settings_pk PRIMARY KEY (scope, key),
+	KEY settings_value (value(10)),
+	INDEX (index),
+	KEY settings_scope USING BTREE (scope)
+);
+`
+
+func TestParse(t *testing.T) {
+	tables, err := querygen.Parse(schema)
+	require.NoError(t, err)
+	require.Equal(t, []querygen.Table{
+		{
+			Name: "users",
+			Columns: []querygen.Column{
+				{Name: "id", Type: "BIGSERIAL", PrimaryKey: true},
+				{Name: "email", Type: "VARCHAR"},
+				{Name: "nick", Type: "TEXT", Nullable: true},
+				{Name: "created_at", Type: "TIMESTAMP WITH TIME ZONE"},
+				{Name: "tags", Type: "TEXT[]", Nullable: true},
+				{Name: "balance", Type: "NUMERIC", Nullable: true},
+			},
+		},
+		{
+			Name: "shop.order_items",
+			Columns: []querygen.Column{
+				{Name: "order_id", Type: "INTEGER", PrimaryKey: true},
+				{Name: "line", Type: "INT", PrimaryKey: true},
+				{Name: "fields", Type: "JSONB", Nullable: true},
+			},
+		},
+		{
+			Name: "settings",
+			Columns: []querygen.Column{
+				{Name: "scope", Type: "VARCHAR", PrimaryKey: true},
+				{Name: "key", Type: "VARCHAR", PrimaryKey: true},
+				{Name: "index", Type: "INT", Nullable: true},
+				{Name: "value", Type: "TEXT", Nullable: true},
+				{Name: "enabled", Type: "TINYINT(1)"},
+				{Name: "hits", Type: "INT UNSIGNED", Nullable: true},
+				{Name: "total", Type: "BIGINT UNSIGNED"},
+			},
+		},
+	}, tables)
+}
+
+func TestParse_TrailingComma(t *testing.T) {
+	tables, err := querygen.Parse("CREATE TABLE users (id INT,);")
+	require.NoError(t, err)
+	require.Equal(t, []querygen.Table{
+		{Name: "users", Columns: []querygen.Column{{Name: "id", Type: "INT", Nullable: true}}},
+	}, tables)
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		ddl  string
+	}{
+		{"not_closed", "CREATE TABLE users (id INT"},
+		{"no_name", "CREATE TABLE (id INT)"},
+		{"no_columns", "CREATE TABLE users (PRIMARY KEY (id))"},
+		{"twice", "CREATE TABLE users (id INT); CREATE TABLE users (id INT);"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := querygen.Parse(tt.ddl)
+			require.ErrorIs(t, err, querygen.Error)
+		})
+	}
+}
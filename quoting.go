@@ -0,0 +1,127 @@
+package query
+
+import (
+	"strconv"
+	"strings"
+)
+
+// IdentQuoting defines when table names, aliases and field names are enclosed into Dialect quotes.
+type IdentQuoting int
+
+const (
+	// QuoteNever renders identifiers as is. Used by default to keep identifiers case folding rules of database.
+	QuoteNever IdentQuoting = iota
+
+	// QuoteNeeded quotes only identifiers which are SQL reserved words, contain unexpected characters
+	// or, for PostgreSQL, contain upper case letters.
+	QuoteNeeded
+
+	// QuoteAlways quotes every identifier.
+	QuoteAlways
+)
+
+// String returns string representation of IdentQuoting value.
+// Implements fmt.Stringer.
+func (quoting IdentQuoting) String() string {
+	switch quoting {
+	case QuoteNever:
+		return "never"
+	case QuoteNeeded:
+		return "needed"
+	case QuoteAlways:
+		return "always"
+	default:
+		return "unknown quoting(" + strconv.Itoa(int(quoting)) + ")"
+	}
+}
+
+// reservedWords contains upper cased SQL keywords which could not be used as not quoted identifiers.
+// It is a common subset of PostgreSQL, MySQL, SQLite, SQL Server and Oracle reserved words.
+var reservedWords = map[string]struct{}{
+	"ALL": {}, "ALTER": {}, "ANALYSE": {}, "ANALYZE": {}, "AND": {}, "ANY": {}, "ARRAY": {}, "AS": {}, "ASC": {},
+	"BETWEEN": {}, "BOTH": {}, "BY": {}, "CASE": {}, "CAST": {}, "CHECK": {}, "COLLATE": {}, "COLUMN": {},
+	"CONSTRAINT": {}, "CREATE": {}, "CROSS": {}, "CURRENT_DATE": {}, "CURRENT_TIME": {}, "CURRENT_TIMESTAMP": {},
+	"CURRENT_USER": {}, "DEFAULT": {}, "DEFERRABLE": {}, "DELETE": {}, "DESC": {}, "DISTINCT": {}, "DO": {},
+	"DROP": {}, "ELSE": {}, "END": {}, "EXCEPT": {}, "EXISTS": {}, "FALSE": {}, "FETCH": {}, "FOR": {},
+	"FOREIGN": {}, "FROM": {}, "FULL": {}, "GRANT": {}, "GROUP": {}, "HAVING": {}, "IN": {}, "INDEX": {},
+	"INNER": {}, "INSERT": {}, "INTERSECT": {}, "INTO": {}, "IS": {}, "JOIN": {}, "KEY": {}, "LEADING": {},
+	"LEFT": {}, "LIKE": {}, "LIMIT": {}, "LOCALTIME": {}, "LOCALTIMESTAMP": {}, "NATURAL": {}, "NOT": {},
+	"NULL": {}, "OFFSET": {}, "ON": {}, "ONLY": {}, "OR": {}, "ORDER": {}, "OUTER": {}, "PRIMARY": {},
+	"REFERENCES": {}, "RETURNING": {}, "RIGHT": {}, "ROW": {}, "ROWS": {}, "SELECT": {}, "SESSION_USER": {},
+	"SET": {}, "SOME": {}, "TABLE": {}, "THEN": {}, "TO": {}, "TOP": {}, "TRAILING": {}, "TRUE": {}, "UNION": {},
+	"UNIQUE": {}, "UPDATE": {}, "USER": {}, "USING": {}, "VALUES": {}, "WHEN": {}, "WHERE": {}, "WINDOW": {},
+	"WITH": {},
+}
+
+// isPlainIdent returns true if identifier contains only latin letters, digits and underscores
+// and does not start with digit.
+func isPlainIdent(ident string) bool {
+	for idx, char := range ident {
+		switch {
+		case char == '_', char >= 'a' && char <= 'z', char >= 'A' && char <= 'Z':
+		case char >= '0' && char <= '9' && idx > 0:
+		default:
+			return false
+		}
+	}
+
+	return len(ident) > 0
+}
+
+// Quoting returns identifiers quoting mode used by dialect.
+func (dialect Dialect) Quoting() IdentQuoting {
+	return dialect.quoting
+}
+
+// WithQuoting returns a copy of Dialect having identifiers quoting mode set to specified value.
+func (dialect Dialect) WithQuoting(quoting IdentQuoting) Dialect {
+	dialect.quoting = quoting
+	return dialect
+}
+
+// quotes returns opening and closing identifier quote characters used by dialect.
+func (dialect Dialect) quotes() (opening string, closing string) {
+	switch dialect.kind {
+	case kindMySQL:
+		return "`", "`"
+	case kindSQLServer:
+		return "[", "]"
+	default:
+		return `"`, `"`
+	}
+}
+
+// needsQuoting returns true if single identifier could not be used as is in dialect.
+func (dialect Dialect) needsQuoting(ident string) bool {
+	if _, reserved := reservedWords[strings.ToUpper(ident)]; reserved {
+		return true
+	}
+
+	if dialect.kind == kindPostgreSQL && strings.ToLower(ident) != ident {
+		return true // PostgreSQL folds not quoted identifiers to lower case
+	}
+
+	return !isPlainIdent(ident)
+}
+
+// QuoteIdent renders table name, alias or field name according to dialect quoting mode.
+// Dot separated identifiers such as "schema.table" are quoted part by part.
+// Quote characters inside identifier are escaped by doubling. The "*" wildcard is never quoted.
+func (dialect Dialect) QuoteIdent(ident string) string {
+	if dialect.quoting == QuoteNever || len(ident) == 0 || ident == "*" {
+		return ident
+	}
+
+	opening, closing := dialect.quotes()
+	parts := strings.Split(ident, ".")
+
+	for idx, part := range parts {
+		if part == "*" || (dialect.quoting == QuoteNeeded && !dialect.needsQuoting(part)) {
+			continue
+		}
+
+		parts[idx] = opening + strings.ReplaceAll(part, closing, closing+closing) + closing
+	}
+
+	return strings.Join(parts, ".")
+}
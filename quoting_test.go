@@ -0,0 +1,113 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/query"
+)
+
+func TestDialect_QuoteIdent(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect query.Dialect
+		ident   string
+		want    string
+	}{
+		{"never_reserved", query.PostgreSQL, "order", "order"},
+		{"always_plain_postgres", query.PostgreSQL.WithQuoting(query.QuoteAlways), "name", `"name"`},
+		{"always_plain_sqlite", query.SQLite.WithQuoting(query.QuoteAlways), "name", `"name"`},
+		{"always_plain_oracle", query.Oracle.WithQuoting(query.QuoteAlways), "name", `"name"`},
+		{"always_plain_mysql", query.MySQL.WithQuoting(query.QuoteAlways), "name", "`name`"},
+		{"always_plain_sql_server", query.SQLServer.WithQuoting(query.QuoteAlways), "name", "[name]"},
+		{"always_escape_postgres", query.PostgreSQL.WithQuoting(query.QuoteAlways), `a"b`, `"a""b"`},
+		{"always_escape_mysql", query.MySQL.WithQuoting(query.QuoteAlways), "a`b", "`a``b`"},
+		{"always_escape_sql_server", query.SQLServer.WithQuoting(query.QuoteAlways), "a]b", "[a]]b]"},
+		{"always_schema", query.PostgreSQL.WithQuoting(query.QuoteAlways), "public.users", `"public"."users"`},
+		{"always_wildcard", query.PostgreSQL.WithQuoting(query.QuoteAlways), "*", "*"},
+		{"needed_plain", query.PostgreSQL.WithQuoting(query.QuoteNeeded), "name", "name"},
+		{"needed_reserved", query.PostgreSQL.WithQuoting(query.QuoteNeeded), "order", `"order"`},
+		{"needed_reserved_upper", query.MySQL.WithQuoting(query.QuoteNeeded), "GROUP", "`GROUP`"},
+		{"needed_mixed_case_postgres", query.PostgreSQL.WithQuoting(query.QuoteNeeded), "createdAt", `"createdAt"`},
+		{"needed_mixed_case_mysql", query.MySQL.WithQuoting(query.QuoteNeeded), "createdAt", "createdAt"},
+		{"needed_space", query.SQLite.WithQuoting(query.QuoteNeeded), "first name", `"first name"`},
+		{"needed_leading_digit", query.SQLite.WithQuoting(query.QuoteNeeded), "1st", `"1st"`},
+		{"needed_schema_part", query.PostgreSQL.WithQuoting(query.QuoteNeeded), "public.user", `public."user"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.dialect.QuoteIdent(tt.ident))
+		})
+	}
+}
+
+func TestBuildQueryAndParamsFor_Quoting(t *testing.T) {
+	users := query.Table("user").As("u")
+	groups := query.Table("group").As("g")
+
+	tests := []struct {
+		name       string
+		builder    query.QueryBuilder
+		dialect    query.Dialect
+		wantSQL    string
+		wantParams []any
+	}{
+		{
+			"select_join_needed",
+			query.SelectManyFrom(users).
+				InnerJoin("group").On(users.Field("group"), query.TableName("group").Field("id")).
+				Fields(users.Field("order"), users.Field("name").As("userName")).
+				Where(query.EqualTo("order", 1), query.IsNull("select")).
+				OrderBy(query.DESC("order")),
+			query.PostgreSQL.WithQuoting(query.QuoteNeeded),
+			`SELECT u."order", u.name AS "userName" FROM "user" AS u ` +
+				`INNER JOIN "group" ON u."group"="group".id ` +
+				`WHERE "order"=$1 AND "select" IS NULL ORDER BY "order" DESC`,
+			[]any{1},
+		},
+		{
+			"select_join_always_mysql",
+			query.SelectFrom(users).InnerJoin(groups).On(users.Field("group_id"), groups.Field("id")),
+			query.MySQL.WithQuoting(query.QuoteAlways),
+			"SELECT * FROM `user` AS `u` INNER JOIN `group` AS `g` ON `u`.`group_id`=`g`.`id`",
+			[]any{},
+		},
+		{
+			"count_always",
+			query.SelectFrom("order").Count(),
+			query.SQLite.WithQuoting(query.QuoteAlways),
+			`SELECT COUNT(*) FROM "order"`,
+			nil,
+		},
+		{
+			"insert_needed",
+			query.InsertInto("user").Values(query.FieldName("group").Value(1)),
+			query.SQLite.WithQuoting(query.QuoteNeeded),
+			`INSERT INTO "user"("group") VALUES (?)`,
+			[]any{1},
+		},
+		{
+			"update_always",
+			query.Update("user").Set(query.FieldName("name").Value("a")).Where(query.EqualTo("id", 1)),
+			query.SQLServer.WithQuoting(query.QuoteAlways),
+			"UPDATE [user] SET [name]=@p1 WHERE [id]=@p2",
+			[]any{"a", 1},
+		},
+		{
+			"delete_needed",
+			query.Delete("user").Where(query.EqualTo("key", 1)),
+			query.MySQL.WithQuoting(query.QuoteNeeded),
+			"DELETE FROM `user` WHERE `key`=?",
+			[]any{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSQL, gotParams, err := tt.builder.BuildQueryAndParamsFor(tt.dialect)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSQL, gotSQL)
+			require.Equal(t, tt.wantParams, gotParams)
+		})
+	}
+}
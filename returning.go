@@ -0,0 +1,38 @@
+package query
+
+import (
+	"fmt"
+)
+
+// returningClause stores SQL RETURNING clause settings shared by InsertBuilder, UpdateBuilder and DeleteBuilder.
+type returningClause struct {
+	enabled bool   // indicates RETURNING clause is requested
+	fields  Fields // fields to return, empty list means all fields
+}
+
+// Fields returns a copy of returningClause enabled and having fields to return set to specified list.
+func (clause returningClause) Fields(fieldSpecs ...FieldDefinition) returningClause {
+	clause.enabled = true
+	clause.fields = clause.fields.Fields(fieldSpecs...)
+
+	return clause
+}
+
+// RenderFor renders SQL RETURNING clause for specified Dialect.
+// Returns empty string if clause is not requested.
+// Returns error if clause is requested but Dialect does not support it.
+func (clause returningClause) RenderFor(dialect Dialect) (sql string, err error) {
+	switch {
+	case !clause.enabled:
+		return "", nil
+	case !dialect.supportsReturning():
+		return "", fmt.Errorf("%w: %v dialect does not support %v clause", Error, dialect, kwReturning)
+	}
+
+	return kwReturning.String() + " " + clause.fields.FieldListFor(dialect), nil
+}
+
+// supportsReturning returns true if dialect supports RETURNING clause in INSERT, UPDATE and DELETE queries.
+func (dialect Dialect) supportsReturning() bool {
+	return dialect.kind == kindPostgreSQL || dialect.kind == kindSQLite
+}
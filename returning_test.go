@@ -0,0 +1,88 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/query"
+)
+
+func TestBuilders_Returning(t *testing.T) {
+	insert := query.InsertInto("t1").Values(query.FieldName("f1").Value(1))
+	update := query.Update("t1").Set(query.FieldName("f1").Value(1)).Where(query.EqualTo("id", 2))
+	del := query.Delete("t1").Where(query.EqualTo("id", 2))
+
+	tests := []struct {
+		name       string
+		builder    query.QueryBuilder
+		dialect    query.Dialect
+		wantSQL    string
+		wantParams []any
+		wantErr    bool
+	}{
+		{
+			"insert_no_returning", insert, query.MySQL,
+			"INSERT INTO t1(f1) VALUES (?)", []any{1}, false,
+		},
+		{
+			"insert_returning_all", insert.Returning(), query.PostgreSQL,
+			"INSERT INTO t1(f1) VALUES ($1) RETURNING *", []any{1}, false,
+		},
+		{
+			"insert_returning_fields", insert.Returning(query.Field("id"), query.Field("created_at as created")),
+			query.PostgreSQL,
+			"INSERT INTO t1(f1) VALUES ($1) RETURNING id, created_at AS created", []any{1}, false,
+		},
+		{
+			"insert_returning_sqlite", insert.Returning(query.Field("id")), query.SQLite,
+			"INSERT INTO t1(f1) VALUES (?) RETURNING id", []any{1}, false,
+		},
+		{
+			"insert_returning_mysql", insert.Returning(query.Field("id")), query.MySQL,
+			"", nil, true,
+		},
+		{
+			"update_returning_fields", update.Returning(query.Field("updated_at")), query.PostgreSQL,
+			"UPDATE t1 SET f1=$1 WHERE id=$2 RETURNING updated_at", []any{1, 2}, false,
+		},
+		{
+			"update_returning_quoted",
+			update.Returning(query.Field("user")), query.PostgreSQL.WithQuoting(query.QuoteNeeded),
+			`UPDATE t1 SET f1=$1 WHERE id=$2 RETURNING "user"`, []any{1, 2}, false,
+		},
+		{
+			"update_returning_sql_server", update.Returning(), query.SQLServer,
+			"", nil, true,
+		},
+		{
+			"delete_returning_fields", del.Returning(query.Field("id"), query.Field("name")), query.SQLite,
+			"DELETE FROM t1 WHERE id=? RETURNING id, name", []any{2}, false,
+		},
+		{
+			"delete_returning_oracle", del.Returning(query.Field("id")), query.Oracle,
+			"", nil, true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSQL, gotParams, err := tt.builder.BuildQueryAndParamsFor(tt.dialect)
+			require.Equalf(t, tt.wantErr, err != nil, "want error %v, got %v", tt.wantErr, err)
+			if err != nil {
+				require.ErrorIs(t, err, query.Error)
+				return
+			}
+			require.Equal(t, tt.wantSQL, gotSQL)
+			require.Equal(t, tt.wantParams, gotParams)
+		})
+	}
+}
+
+func TestInsertBuilder_Returning_Immutable(t *testing.T) {
+	original := query.InsertInto("t1").Values(query.FieldName("f1").Value(1))
+	_ = original.Returning(query.Field("id"))
+
+	sql, _, err := original.BuildQueryAndParams()
+	require.NoError(t, err)
+	require.Equal(t, "INSERT INTO t1(f1) VALUES ($1)", sql)
+}
@@ -11,75 +11,120 @@ import (
 // It also provides method Count() to generate SelectCountBuilder.
 type BaseSelectBuilder struct {
 	BaseBuilder
-	baseTable TableIdent
-	joins     []TableJoiner
-	fields    Fields
-	where     WhereClause
+	baseTable  TableIdent
+	joins      []TableJoiner
+	fields     Fields
+	where      WhereClause
+	distinct   bool              // select distinct rows only
+	distinctOn []FieldDefinition // fields to select first row of each distinct values set by, PostgreSQL only
+	groupBy    []FieldDefinition // fields to group rows by
+	having     WhereClause       // groups conditions
+	lock       rowLock           // rows locking clause, rendered by SelectManyBuilder and SelectSingleBuilder only
 }
 
-// String returns a string representation of BaseSelectBuilder.
+// String returns debug representation of BaseSelectBuilder query having parameters inlined, see DebugSQL.
+// Implements fmt.Stringer.
 func (query BaseSelectBuilder) String() string {
-	return strings.Join([]string{
-		fmt.Sprintf("%T(%v)", query, query.RenderFrom()),
-		query.fields.String(),
-		query.where.String(),
-	}, ",")
+	return DebugSQL(query)
 }
 
-func (query BaseSelectBuilder) RenderSQL() (sql string) {
-	tokens := append([]string{},
-		DoSelect.String(),
-		query.fields.FieldList(),
+// RenderDialect renders SQL SELECT query using specified Dialect placeholders.
+// Takes existed parameters count (0 means no parameters are defined yet) to number substitutions.
+// Implements DialectClauseRenderer.
+func (query BaseSelectBuilder) RenderDialect(dialect Dialect, parametersCount int) (sql string) {
+	tokens := []string{DoSelect.String()}
+
+	switch {
+	case len(query.distinctOn) > 0:
+		distinctFields := make([]string, len(query.distinctOn))
+		for idx, field := range query.distinctOn {
+			distinctFields[idx] = field.renderOperandFor(dialect)
+		}
+
+		tokens = append(tokens, kwDistinct.String(), kwOn.String(), "("+strings.Join(distinctFields, ", ")+")")
+	case query.distinct:
+		tokens = append(tokens, kwDistinct.String())
+	}
+
+	tokens = append(tokens,
+		query.fields.FieldListFor(dialect),
 		kwFrom.String(),
-		query.baseTable.RenderFrom(),
+		query.baseTable.RenderFromFor(dialect),
 	)
 
 	for _, joiner := range query.joins {
-		tokens = append(tokens, joiner.RenderFrom())
+		tokens = append(tokens, joiner.RenderFromFor(dialect))
 	}
 
 	if len(query.where.Conditions()) > 0 {
-		tokens = append(tokens, kwWhere.String(), query.where.RenderSQL())
+		tokens = append(tokens, kwWhere.String(), query.where.RenderDialect(dialect, parametersCount))
+		parametersCount += len(query.where.ValuesFor(dialect))
 	}
 
-	return strings.Join(tokens, " ")
-}
-
-func (query BaseSelectBuilder) Render(parametersCount int) (sql string) {
-	tokens := append([]string{},
-		DoSelect.String(),
-		query.fields.FieldList(),
-		kwFrom.String(),
-		query.baseTable.RenderFrom(),
-	)
+	if len(query.groupBy) > 0 {
+		groupFields := make([]string, len(query.groupBy))
+		for idx, field := range query.groupBy {
+			groupFields[idx] = field.renderOperandFor(dialect)
+		}
 
-	for _, joiner := range query.joins {
-		tokens = append(tokens, joiner.RenderFrom())
+		tokens = append(tokens, kwGroupBy.String(), strings.Join(groupFields, ", "))
 	}
 
-	if len(query.where.Conditions()) > 0 {
-		tokens = append(tokens, kwWhere.String(), query.where.Render(parametersCount))
+	if len(query.having.Conditions()) > 0 {
+		tokens = append(tokens, kwHaving.String(), query.having.RenderDialect(dialect, parametersCount))
 	}
 
 	return strings.Join(tokens, " ")
 }
 
+// RenderSQL renders SQL SELECT query using standard sql "?"(question) substitutions.
+// Implements RawClauseRenderer.
+func (query BaseSelectBuilder) RenderSQL() (sql string) {
+	return query.RenderDialect(rawDialect, 0)
+}
+
+// Render renders SQL SELECT query using "$<number>" substitutions.
+// Implements CountingClauseRenderer.
+func (query BaseSelectBuilder) Render(parametersCount int) (sql string) {
+	return query.RenderDialect(PostgreSQL, parametersCount)
+}
+
+// Values returns a set of parameters to substitute into rendered query.
+// Implements ValuesProvider.
 func (query BaseSelectBuilder) Values() (params []any) {
+	return query.ValuesFor(PostgreSQL)
+}
+
+// ValuesFor returns a set of parameters to substitute into query rendered with specified Dialect.
+// Implements SubQuery.
+func (query BaseSelectBuilder) ValuesFor(dialect Dialect) (params []any) {
 	params = make([]any, 0)
 
 	if len(query.where.Conditions()) > 0 {
-		params = append(params, query.where.Values()...)
+		params = append(params, query.where.ValuesFor(dialect)...)
+	}
+
+	if len(query.having.Conditions()) > 0 {
+		params = append(params, query.having.ValuesFor(dialect)...)
 	}
 
 	return params
 }
 
+// RenderFrom returns string representation of table name or tables join with possible tables aliases.
+// Implements ClauseFromRenderer.
 func (query BaseSelectBuilder) RenderFrom() (fromClause string) {
+	return query.RenderFromFor(PostgreSQL)
+}
+
+// RenderFromFor returns string representation of table name or tables join with possible tables aliases.
+// Identifiers are quoted according to specified Dialect quoting mode.
+func (query BaseSelectBuilder) RenderFromFor(dialect Dialect) (fromClause string) {
 	fromClauseItems := make([]string, 1+len(query.joins))
-	fromClauseItems[0] = query.baseTable.RenderFrom()
+	fromClauseItems[0] = query.baseTable.RenderFromFor(dialect)
 
 	for idx, tableJoiner := range query.joins {
-		fromClauseItems[1+idx] = tableJoiner.RenderFrom()
+		fromClauseItems[1+idx] = tableJoiner.RenderFromFor(dialect)
 	}
 
 	return strings.Join(fromClauseItems, " ")
@@ -87,25 +132,34 @@ func (query BaseSelectBuilder) RenderFrom() (fromClause string) {
 
 // BuildQueryAndParams generates sql query string with desired parameters set.
 // If query generation failed returns empty query and parameters set or non-nil error.
+// Uses PostgreSQL "$N" placeholders, use BuildQueryAndParamsFor to generate query for another Dialect.
 func (query BaseSelectBuilder) BuildQueryAndParams() (sql string, params []interface{}, err error) {
-	params = make([]any, 0)
-	tokens := append([]string{},
-		DoSelect.String(),
-		query.fields.FieldList(),
-		kwFrom.String(),
-		query.baseTable.RenderFrom(),
-	)
+	return query.BuildQueryAndParamsFor(PostgreSQL)
+}
 
-	for _, joiner := range query.joins {
-		tokens = append(tokens, joiner.RenderFrom())
+// BuildQueryAndParamsFor generates sql query string with desired parameters set using specified Dialect.
+// If query generation failed returns empty query and parameters set or non-nil error.
+// Returns error if DistinctOn is used with Dialect other than PostgreSQL.
+func (query BaseSelectBuilder) BuildQueryAndParamsFor(dialect Dialect) (sql string, params []interface{}, err error) {
+	if err = query.validateDistinct(dialect); err != nil {
+		return "", nil, err
 	}
 
-	if len(query.where.Conditions()) > 0 {
-		tokens = append(tokens, kwWhere.String(), query.where.Render(0))
-		params = append(params, query.where.Values()...)
+	return query.RenderDialect(dialect, 0), query.ValuesFor(dialect), nil
+}
+
+// validateDistinct returns error if DISTINCT ON clause is set but not supported by specified Dialect.
+func (query BaseSelectBuilder) validateDistinct(dialect Dialect) error {
+	if len(query.distinctOn) > 0 && dialect.kind != kindPostgreSQL {
+		return fmt.Errorf("%w: %v dialect does not support DISTINCT ON", Error, dialect)
 	}
 
-	return strings.Join(tokens, " "), params, nil
+	return nil
+}
+
+// isAggregated returns true if query selects distinct rows or groups rather than table rows.
+func (query BaseSelectBuilder) isAggregated() bool {
+	return query.distinct || len(query.distinctOn) > 0 || len(query.groupBy) > 0 || len(query.having.Conditions()) > 0
 }
 
 // TableName returns table name to fetch records from.
@@ -135,6 +189,45 @@ func (query BaseSelectBuilder) Where(fieldConditions ...Condition) (updated Base
 	return updated
 }
 
+// Distinct returns a copy of BaseSelectBuilder selecting distinct rows only using SQL SELECT DISTINCT.
+func (query BaseSelectBuilder) Distinct() (updated BaseSelectBuilder) {
+	updated = query
+	updated.distinct = true
+
+	return updated
+}
+
+// DistinctOn returns a copy of BaseSelectBuilder selecting the first row of each set of rows
+// having the same values of specified fields using PostgreSQL SELECT DISTINCT ON clause.
+// Ordering should start with the same fields to make the first row predictable.
+// Note all fields should be set one step as DistinctOn call resets fields added before.
+// Query build fails for dialects other than PostgreSQL.
+func (query BaseSelectBuilder) DistinctOn(fields ...FieldDefinition) (updated BaseSelectBuilder) {
+	updated = query
+	updated.distinctOn = append(make([]FieldDefinition, 0, len(fields)), fields...)
+
+	return updated
+}
+
+// GroupBy returns a copy of BaseSelectBuilder having rows grouped by specified fields using SQL GROUP BY clause.
+// Note all fields should be set one step as GroupBy call resets grouping fields added before.
+func (query BaseSelectBuilder) GroupBy(fields ...FieldDefinition) (updated BaseSelectBuilder) {
+	updated = query
+	updated.groupBy = append(make([]FieldDefinition, 0, len(fields)), fields...)
+
+	return updated
+}
+
+// Having adds groups conditions rendered as SQL HAVING clause and returns modified BaseSelectBuilder.
+// If any conditions are already added, adds new conditions group joined with logical AND.
+// Conditions parameters are numbered after WHERE clause parameters.
+func (query BaseSelectBuilder) Having(groupConditions ...Condition) (updated BaseSelectBuilder) {
+	updated = query
+	updated.having = updated.having.GroupAND(groupConditions...)
+
+	return updated
+}
+
 // InsertInto generates SQL INSERT query builder using stored table name.
 // Insert values are optional and could be set later with InsertBuilder.Values.
 // Note generated InsertInto will receive only base TableIdent to generate insert into it.
@@ -259,9 +352,12 @@ func SelectFrom[T TableNameParameter](tableNameProvider T) BaseSelectBuilder {
 	}
 
 	return BaseSelectBuilder{
-		baseTable: table,
-		joins:     make([]TableJoiner, 0),
-		where:     NewWhere(),
-		fields:    NewFields(),
+		baseTable:  table,
+		joins:      make([]TableJoiner, 0),
+		where:      NewWhere(),
+		fields:     NewFields(),
+		distinctOn: make([]FieldDefinition, 0),
+		groupBy:    make([]FieldDefinition, 0),
+		having:     NewWhere(),
 	}
 }
@@ -182,3 +182,77 @@ func TestBaseSelectBuilder_RenderSQL(t *testing.T) {
 		})
 	}
 }
+
+func TestBaseSelectBuilder_Distinct(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder interface {
+			BuildQueryAndParamsFor(dialect query.Dialect) (string, []any, error)
+		}
+		dialect query.Dialect
+		wantSQL string
+		wantErr bool
+	}{
+		{
+			"distinct",
+			query.SelectFrom("orders").Fields(query.Field("customer_id")).Distinct(),
+			query.MySQL,
+			"SELECT DISTINCT customer_id FROM orders",
+			false,
+		},
+		{
+			"distinct_many",
+			query.SelectManyFrom("orders").Fields(query.Field("customer_id")).Distinct().Limit(5),
+			query.SQLite,
+			"SELECT DISTINCT customer_id FROM orders LIMIT ?",
+			false,
+		},
+		{
+			"distinct_on",
+			query.SelectManyFrom("orders").
+				DistinctOn(query.Field("customer_id")).
+				OrderBy(query.ASC("customer_id"), query.DESC("created_at")),
+			query.PostgreSQL,
+			"SELECT DISTINCT ON (customer_id) * FROM orders ORDER BY customer_id ASC, created_at DESC",
+			false,
+		},
+		{
+			"distinct_on_single",
+			query.SelectSingleFrom("orders").DistinctOn(query.Field("customer_id"), query.Lower("status")),
+			query.PostgreSQL,
+			"SELECT DISTINCT ON (customer_id, LOWER(status)) * FROM orders LIMIT 1",
+			false,
+		},
+		{"distinct_on_mysql", query.SelectFrom("orders").DistinctOn(query.Field("customer_id")), query.MySQL, "", true},
+		{"distinct_on_many_sqlite", query.SelectManyFrom("orders").DistinctOn(query.Field("id")), query.SQLite, "", true},
+		{"distinct_on_single_oracle", query.SelectSingleFrom("orders").DistinctOn(query.Field("id")), query.Oracle, "", true},
+		{
+			"count_distinct",
+			query.Count(query.SelectFrom("orders").Where(query.EqualTo("status", "paid")), "customer_id").Distinct(),
+			query.PostgreSQL,
+			"SELECT COUNT(DISTINCT customer_id) FROM orders WHERE status=$1",
+			false,
+		},
+		{"count_distinct_all", query.SelectFrom("orders").Count().Distinct(), query.PostgreSQL, "", true},
+		{
+			"count_grouped_qualified_field",
+			query.Count(query.SelectFrom("orders o").Fields(query.Field("o.customer_id")).
+				GroupBy(query.Field("o.customer_id")), "o.customer_id"),
+			query.PostgreSQL,
+			"SELECT COUNT(customer_id) FROM (SELECT o.customer_id FROM orders AS o GROUP BY o.customer_id) counted",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, _, err := tt.builder.BuildQueryAndParamsFor(tt.dialect)
+			if tt.wantErr {
+				require.ErrorIs(t, err, query.Error)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSQL, sql)
+		})
+	}
+}
@@ -1,7 +1,7 @@
 package query
 
 import (
-	"strconv"
+	"fmt"
 )
 
 const (
@@ -11,10 +11,13 @@ const (
 // SelectManyBuilder extends BaseSelectBuilder helps to build SQL SELECT queries with ordering, offset and limit.
 type SelectManyBuilder struct {
 	BaseSelectBuilder
-	offset    uint
-	limit     int
-	order     []FieldSorting
-	tableSpec bool // render query with field spec including table names
+	offset     uint
+	limit      int
+	order      []FieldSorting
+	cursor     Cursor    // keyset pagination cursor, see After and Before
+	before     bool      // select rows preceding cursor
+	totalCount FieldName // alias of total rows count field computed by window function, see WithTotalCount
+	tableSpec  bool      // render query with field spec including table names
 }
 
 // RenderFrom renders a table name or list of tables joins to represent SQL clause FROM contents.
@@ -22,10 +25,49 @@ func (query SelectManyBuilder) RenderFrom() string {
 	return query.BaseSelectBuilder.RenderFrom()
 }
 
+// String returns debug representation of SelectManyBuilder query having parameters inlined, see DebugSQL.
+// Implements fmt.Stringer.
+func (query SelectManyBuilder) String() string {
+	return DebugSQL(query)
+}
+
 // BuildQueryAndParams generates sql query string with desired parameters set.
 // If query generation failed returns empty query and parameters set or non-nil error.
+// Uses PostgreSQL "$N" placeholders, use BuildQueryAndParamsFor to generate query for another Dialect.
 func (query SelectManyBuilder) BuildQueryAndParams() (sql string, params []interface{}, err error) {
-	sql, params, err = query.BaseSelectBuilder.BuildQueryAndParams()
+	return query.BuildQueryAndParamsFor(PostgreSQL)
+}
+
+// BuildQueryAndParamsFor generates sql query string with desired parameters set using specified Dialect.
+// If query generation failed returns empty query and parameters set or non-nil error.
+// Returns error if DISTINCT ON or rows locking clause is not supported by Dialect, i.e. Oracle does not lock paginated rows,
+// or keyset pagination cursor does not match ordering fields, or total count requested for distinct rows.
+func (query SelectManyBuilder) BuildQueryAndParamsFor(dialect Dialect) (sql string, params []interface{}, err error) {
+	if err = query.validateDistinct(dialect); err != nil {
+		return "", nil, err
+	}
+
+	if err = query.validateTotalCount(); err != nil {
+		return "", nil, err
+	}
+
+	if err = query.validateCursor(); err != nil {
+		return "", nil, err
+	}
+
+	if err = query.lock.validate(dialect, query.offset > 0 || query.limit > 0); err != nil {
+		return "", nil, err
+	}
+
+	return query.RenderDialect(dialect, 0), query.ValuesFor(dialect), nil
+}
+
+// RenderDialect renders SQL SELECT query including ordering and pagination using specified Dialect placeholders.
+// Takes existed parameters count (0 means no parameters are defined yet) to number substitutions.
+// Implements DialectClauseRenderer.
+func (query SelectManyBuilder) RenderDialect(dialect Dialect, parametersCount int) (sql string) {
+	query = query.withKeyset().withTotalCount(dialect)
+	sql = query.BaseSelectBuilder.RenderDialect(dialect, parametersCount)
 
 	if len(query.order) > 0 {
 		sql += " ORDER BY "
@@ -33,22 +75,90 @@ func (query SelectManyBuilder) BuildQueryAndParams() (sql string, params []inter
 			if idx > 0 {
 				sql += ", "
 			}
-			sql += order.Render()
+			sql += order.RenderFor(dialect)
 		}
 	}
 
-	if query.offset > 0 {
-		params = append(params, query.offset)
-		sql += " OFFSET $" + strconv.Itoa(len(params))
+	pagination, _ := query.renderPagination(dialect, parametersCount, query.BaseSelectBuilder.ValuesFor(dialect))
+	if len(pagination) > 0 {
+		sql += " " + pagination
+	}
+
+	if lock := query.lock.renderFor(dialect); len(lock) > 0 {
+		sql += " " + lock
 	}
 
-	if query.limit > 0 {
-		params = append(params, uint(query.limit))
-		sql += " LIMIT $" + strconv.Itoa(len(params))
+	return sql
+}
 
+// RenderSQL renders SQL SELECT query including ordering and pagination using standard sql "?"(question) substitutions.
+// Implements RawClauseRenderer.
+func (query SelectManyBuilder) RenderSQL() (sql string) {
+	return query.RenderDialect(rawDialect, 0)
+}
+
+// Render renders SQL SELECT query including ordering and pagination using "$<number>" substitutions.
+// Implements CountingClauseRenderer.
+func (query SelectManyBuilder) Render(parametersCount int) (sql string) {
+	return query.RenderDialect(PostgreSQL, parametersCount)
+}
+
+// Values returns a set of parameters to substitute into rendered query including offset and limit values.
+// Offset and limit values are ordered as PostgreSQL expects, use ValuesFor to get them ordered for another Dialect.
+// Implements ValuesProvider.
+func (query SelectManyBuilder) Values() (params []any) {
+	return query.ValuesFor(PostgreSQL)
+}
+
+// ValuesFor returns a set of parameters to substitute into query rendered with specified Dialect.
+// Implements SubQuery.
+func (query SelectManyBuilder) ValuesFor(dialect Dialect) (params []any) {
+	query = query.withKeyset()
+	_, params = query.renderPagination(dialect, 0, query.BaseSelectBuilder.ValuesFor(dialect))
+	return params
+}
+
+// renderPagination renders OFFSET and LIMIT clauses in Dialect required order.
+// Takes existed parameters count and parameters collected before,
+// returns them extended with offset and limit values.
+func (query SelectManyBuilder) renderPagination(
+	dialect Dialect, parametersCount int, params []any,
+) (sql string, updated []any) {
+	return renderPagination(dialect, parametersCount, params, len(query.order) > 0, query.offset, query.limit)
+}
+
+// renderPagination renders OFFSET and LIMIT clauses in Dialect required order using parameters substitutions.
+// Takes existed parameters count, parameters collected before and ORDER BY presence flag,
+// returns parameters extended with offset and limit values. Zero offset and not positive limit are not rendered.
+func renderPagination(
+	dialect Dialect, parametersCount int, params []any, ordered bool, offsetValue uint, limitValue int,
+) (sql string, updated []any) {
+	var offset, limit string
+
+	updated = params
+
+	addOffset := func() {
+		if offsetValue > 0 {
+			updated = append(updated, offsetValue)
+			offset = dialect.Placeholder(parametersCount + len(updated))
+		}
+	}
+	addLimit := func() {
+		if limitValue > 0 {
+			updated = append(updated, uint(limitValue))
+			limit = dialect.Placeholder(parametersCount + len(updated))
+		}
 	}
 
-	return sql, params, nil
+	if dialect.limitBeforeOffset() {
+		addLimit()
+		addOffset()
+	} else {
+		addOffset()
+		addLimit()
+	}
+
+	return dialect.renderPagination(ordered, offset, limit), updated
 }
 
 // FieldList returns spec list string with their possible aliases to build select query.
@@ -115,6 +225,77 @@ func (query SelectManyBuilder) Where(fieldConditions ...Condition) SelectManyBui
 	return query
 }
 
+// Distinct returns a copy of SelectManyBuilder selecting distinct rows only using SQL SELECT DISTINCT.
+func (query SelectManyBuilder) Distinct() SelectManyBuilder {
+	query.BaseSelectBuilder = query.BaseSelectBuilder.Distinct()
+	return query
+}
+
+// DistinctOn returns a copy of SelectManyBuilder selecting the first row of each set of rows
+// having the same values of specified fields using PostgreSQL SELECT DISTINCT ON clause.
+// Ordering should start with the same fields to make the first row predictable.
+// Query build fails for dialects other than PostgreSQL.
+func (query SelectManyBuilder) DistinctOn(fields ...FieldDefinition) SelectManyBuilder {
+	query.BaseSelectBuilder = query.BaseSelectBuilder.DistinctOn(fields...)
+	return query
+}
+
+// GroupBy returns a copy of SelectManyBuilder having rows grouped by specified fields using SQL GROUP BY clause.
+// Note all fields should be set one step as GroupBy call resets grouping fields added before.
+func (query SelectManyBuilder) GroupBy(fields ...FieldDefinition) SelectManyBuilder {
+	query.BaseSelectBuilder = query.BaseSelectBuilder.GroupBy(fields...)
+	return query
+}
+
+// Having adds groups conditions rendered as SQL HAVING clause and returns modified SelectManyBuilder.
+// If any conditions are already added, adds new conditions group joined with logical AND.
+func (query SelectManyBuilder) Having(groupConditions ...Condition) SelectManyBuilder {
+	query.having = query.having.GroupAND(groupConditions...)
+	return query
+}
+
+// ForUpdate returns a copy of SelectManyBuilder locking selected rows for update using SQL FOR UPDATE clause.
+// Note query build fails for dialects not supporting rows locking such as SQLite.
+func (query SelectManyBuilder) ForUpdate() SelectManyBuilder {
+	query.lock = query.lock.withStrength(lockForUpdate)
+	return query
+}
+
+// ForNoKeyUpdate returns a copy of SelectManyBuilder locking selected rows using SQL FOR NO KEY UPDATE clause.
+// Weaker than ForUpdate as it does not block inserts referencing locked rows. Supported by PostgreSQL only.
+func (query SelectManyBuilder) ForNoKeyUpdate() SelectManyBuilder {
+	query.lock = query.lock.withStrength(lockForNoKeyUpdate)
+	return query
+}
+
+// ForShare returns a copy of SelectManyBuilder locking selected rows against updates using SQL FOR SHARE clause.
+// Note query build fails for dialects not supporting shared locks such as SQLite or Oracle.
+func (query SelectManyBuilder) ForShare() SelectManyBuilder {
+	query.lock = query.lock.withStrength(lockForShare)
+	return query
+}
+
+// NoWait returns a copy of SelectManyBuilder failing immediately if any selected row is already locked.
+// Requires one of ForUpdate, ForNoKeyUpdate or ForShare. Overrides SkipLocked.
+func (query SelectManyBuilder) NoWait() SelectManyBuilder {
+	query.lock = query.lock.withWait(lockNoWait)
+	return query
+}
+
+// SkipLocked returns a copy of SelectManyBuilder skipping already locked rows, i.e. to fetch job queue items.
+// Requires one of ForUpdate, ForNoKeyUpdate or ForShare. Overrides NoWait.
+func (query SelectManyBuilder) SkipLocked() SelectManyBuilder {
+	query.lock = query.lock.withWait(lockSkipLocked)
+	return query
+}
+
+// Of returns a copy of SelectManyBuilder locking rows of specified tables only.
+// Use table aliases if tables are aliased in query. Requires one of ForUpdate, ForNoKeyUpdate or ForShare.
+func (query SelectManyBuilder) Of(tables ...TableName) SelectManyBuilder {
+	query.lock = query.lock.withTables(tables...)
+	return query
+}
+
 // Update generates table UpdateBuilder.
 // Note generated UpdateBuilder will use only base table even if join conditions added to SelectManyBuilder instance.
 func (query SelectManyBuilder) Update(values ...FieldValue) UpdateBuilder {
@@ -177,9 +358,55 @@ func (query SelectManyBuilder) FullJoin(rightTable TableName) IncompleteSelectMa
 	return query.Join(rightTable, FullJoin)
 }
 
-// Count creates an CountBuilder using parameters of this SelectManyBuilder.
+// Count creates CountBuilder counting all rows selected by SelectManyBuilder regardless of pagination.
+// Joins and WHERE conditions are kept while ordering, offset, limit, keyset cursor and rows locking are dropped.
+// Distinct rows or groups are counted using subquery, see CountBuilder.BuildQueryAndParamsFor.
 func (query SelectManyBuilder) Count() CountBuilder {
-	return Count(query.BaseSelectBuilder)
+	base := query.BaseSelectBuilder // ordering, pagination, keyset cursor and total count field are not copied
+	base.lock = rowLock{}
+
+	return Count(base)
+}
+
+// WithTotalCount returns a copy of SelectManyBuilder selecting additional field having specified alias
+// filled with count of all rows matched by query regardless of offset and limit using COUNT(*) OVER () window function.
+// Allows to fetch a page and total rows count in the same statement, add alias tagged field to row struct to scan it.
+// Note keyset pagination conditions restrict counted rows too, use Count to get total count in such case.
+// Query build fails if distinct rows selected as window function is computed before duplicates removal.
+func (query SelectManyBuilder) WithTotalCount(alias FieldName) SelectManyBuilder {
+	query.totalCount = alias
+	return query
+}
+
+// validateTotalCount returns error if total count field alias is invalid or total count could not be computed.
+func (query SelectManyBuilder) validateTotalCount() error {
+	switch {
+	case len(query.totalCount) == 0:
+		return nil
+	case query.distinct || len(query.distinctOn) > 0:
+		return fmt.Errorf("%w: total count could not be computed over distinct rows", Error)
+	}
+
+	return query.totalCount.Validate()
+}
+
+// withTotalCount returns a copy of SelectManyBuilder having total count field appended to selected fields.
+// All rows fields are selected explicitly if no fields set, Oracle requires them qualified by table name.
+func (query SelectManyBuilder) withTotalCount(dialect Dialect) SelectManyBuilder {
+	if len(query.totalCount) == 0 {
+		return query
+	}
+
+	fields := query.fields.FieldDefinitions()
+	if len(fields) == 0 && dialect.kind == kindOracle {
+		fields = append(fields, query.baseTable.Field("*"))
+	} else if len(fields) == 0 {
+		fields = append(fields, Field("*"))
+	}
+
+	query.fields = query.fields.Fields(append(fields, CountOf().Over(Window{}).As(query.totalCount))...)
+
+	return query
 }
 
 // SelectManyFromBase makes a new query SelectManyBuilder instance using supplied BaseSelectBuilder.
@@ -182,6 +182,30 @@ func TestTableQuery_Render(t *testing.T) {
 			[]interface{}{11, 13},
 			false,
 		},
+		{
+			"group_by",
+			query.SelectManyFrom("orders").
+				Fields(query.Field("customer_id"), query.Field("status")).
+				GroupBy(query.Field("customer_id"), query.Field("status")),
+			"SELECT customer_id, status FROM orders GROUP BY customer_id, status",
+			[]interface{}{},
+			false,
+		},
+		{
+			"where_group_by_having_order_limit",
+			query.SelectManyFrom("orders").
+				Fields(query.Field("customer_id")).
+				Where(query.EqualTo("status", "paid")).
+				GroupBy(query.Field("customer_id")).
+				Having(query.GreaterThan("COUNT(*)", 5)).
+				Having(query.Less("MAX(total)", 100)).
+				OrderBy(query.ASC("customer_id")).
+				Limit(10),
+			"SELECT customer_id FROM orders WHERE status=$1 GROUP BY customer_id " +
+				"HAVING COUNT(*)>$2 AND MAX(total)<$3 ORDER BY customer_id ASC LIMIT $4",
+			[]interface{}{"paid", 5, 100, uint(10)},
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -219,6 +243,19 @@ func TestBuilder_Operation(t *testing.T) {
 	require.Equal(t, query.DoSelect, query.SelectManyFrom("test").Operation())
 }
 
+func TestSelectManyBuilder_Values(t *testing.T) {
+	paginated := query.SelectManyFrom("users").
+		Where(query.EqualTo("x", 7)).
+		OrderBy(query.ASC("id")).
+		Offset(5).
+		Limit(10)
+
+	require.Equal(t, "SELECT * FROM users WHERE x=$1 ORDER BY id ASC OFFSET $2 LIMIT $3", paginated.Render(0))
+	require.Equal(t, []any{7, uint(5), uint(10)}, paginated.Values())
+	require.Equal(t, []any{7, uint(5), uint(10)}, paginated.ValuesFor(query.PostgreSQL))
+	require.Equal(t, []any{7, uint(10), uint(5)}, paginated.ValuesFor(query.MySQL))
+}
+
 func TestSelectManyFrom(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -253,3 +290,119 @@ func TestSelectManyFrom(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectManyBuilder_Count(t *testing.T) {
+	posts := query.SelectManyFrom("posts").Where(query.EqualTo("author", 1)).
+		OrderBy(query.DESC("created")).Offset(20).Limit(10)
+
+	tests := []struct {
+		name       string
+		builder    query.SelectManyBuilder
+		dialect    query.Dialect
+		wantSQL    string
+		wantParams []any
+		wantErr    bool
+	}{
+		{
+			"pagination_dropped",
+			posts.After(query.Cursor{5}),
+			query.PostgreSQL,
+			"SELECT COUNT(*) FROM posts WHERE author=$1",
+			[]any{1},
+			false,
+		},
+		{
+			"lock_and_total_count_dropped",
+			posts.Before(query.Cursor{5}).ForUpdate().SkipLocked().WithTotalCount("total"),
+			query.PostgreSQL,
+			"SELECT COUNT(*) FROM posts WHERE author=$1",
+			[]any{1},
+			false,
+		},
+		{
+			"distinct_wrapped",
+			posts.Fields(query.Field("tag")).Distinct(),
+			query.MySQL,
+			"SELECT COUNT(*) FROM (SELECT DISTINCT tag FROM posts WHERE author=?) counted",
+			[]any{1},
+			false,
+		},
+		{
+			"group_by_wrapped",
+			posts.Fields(query.Field("tag")).GroupBy(query.Field("tag")).Having(query.GreaterThanExpr(query.CountOf(), 2)),
+			query.PostgreSQL,
+			"SELECT COUNT(*) FROM (SELECT tag FROM posts WHERE author=$1 GROUP BY tag HAVING COUNT(*)>$2) counted",
+			[]any{1, 2},
+			false,
+		},
+		{"distinct_on_rejected", posts.DistinctOn(query.Field("tag")), query.MySQL, "", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, params, err := tt.builder.Count().BuildQueryAndParamsFor(tt.dialect)
+			if tt.wantErr {
+				require.ErrorIs(t, err, query.Error)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSQL, sql)
+			require.Equal(t, tt.wantParams, params)
+		})
+	}
+}
+
+func TestSelectManyBuilder_WithTotalCount(t *testing.T) {
+	posts := query.SelectManyFrom("posts").Where(query.EqualTo("author", 1)).
+		OrderBy(query.DESC("created")).Offset(20).Limit(10).WithTotalCount("total")
+
+	tests := []struct {
+		name       string
+		builder    query.SelectManyBuilder
+		dialect    query.Dialect
+		wantSQL    string
+		wantParams []any
+		wantErr    bool
+	}{
+		{
+			"all_fields",
+			posts,
+			query.PostgreSQL,
+			"SELECT *, COUNT(*) OVER () AS total FROM posts WHERE author=$1 ORDER BY created DESC OFFSET $2 LIMIT $3",
+			[]any{1, uint(20), uint(10)},
+			false,
+		},
+		{
+			"oracle_qualified",
+			posts,
+			query.Oracle,
+			"SELECT posts.*, COUNT(*) OVER () AS total FROM posts WHERE author=:1 ORDER BY created DESC " +
+				"OFFSET :2 ROWS FETCH NEXT :3 ROWS ONLY",
+			[]any{1, uint(20), uint(10)},
+			false,
+		},
+		{
+			"fields_kept",
+			posts.Fields(query.Field("id")),
+			query.MySQL,
+			"SELECT id, COUNT(*) OVER () AS total FROM posts WHERE author=? ORDER BY created DESC LIMIT ? OFFSET ?",
+			[]any{1, uint(10), uint(20)},
+			false,
+		},
+		{"distinct_rejected", posts.Distinct(), query.PostgreSQL, "", nil, true},
+		{"invalid_alias_rejected", posts.WithTotalCount("total count"), query.PostgreSQL, "", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, params, err := tt.builder.BuildQueryAndParamsFor(tt.dialect)
+			if tt.wantErr {
+				require.ErrorIs(t, err, query.Error)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSQL, sql)
+			require.Equal(t, tt.wantParams, params)
+		})
+	}
+}
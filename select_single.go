@@ -10,11 +10,57 @@ func (query SelectSingleBuilder) RenderFrom() string {
 	return query.BaseSelectBuilder.RenderFrom()
 }
 
+// String returns debug representation of SelectSingleBuilder query having parameters inlined, see DebugSQL.
+// Implements fmt.Stringer.
+func (query SelectSingleBuilder) String() string {
+	return DebugSQL(query)
+}
+
 // BuildQueryAndParams generates sql query string with desired parameters set.
 // If query generation failed returns empty query and parameters set or non-nil error.
+// Uses PostgreSQL "$N" placeholders, use BuildQueryAndParamsFor to generate query for another Dialect.
 func (query SelectSingleBuilder) BuildQueryAndParams() (sql string, params []interface{}, err error) {
-	sql, params, err = query.BaseSelectBuilder.BuildQueryAndParams()
-	return sql + " LIMIT 1", params, nil
+	return query.BuildQueryAndParamsFor(PostgreSQL)
+}
+
+// BuildQueryAndParamsFor generates sql query string with desired parameters set using specified Dialect.
+// If query generation failed returns empty query and parameters set or non-nil error.
+// Returns error if DISTINCT ON or rows locking clause is not supported by Dialect.
+// Oracle does not lock paginated rows so locking always fails for it.
+func (query SelectSingleBuilder) BuildQueryAndParamsFor(dialect Dialect) (sql string, params []interface{}, err error) {
+	if err = query.validateDistinct(dialect); err != nil {
+		return "", nil, err
+	}
+
+	if err = query.lock.validate(dialect, true); err != nil {
+		return "", nil, err
+	}
+
+	return query.RenderDialect(dialect, 0), query.ValuesFor(dialect), nil
+}
+
+// RenderDialect renders SQL SELECT query limited to single row using specified Dialect placeholders.
+// Takes existed parameters count (0 means no parameters are defined yet) to number substitutions.
+// Implements DialectClauseRenderer.
+func (query SelectSingleBuilder) RenderDialect(dialect Dialect, parametersCount int) (sql string) {
+	sql = query.BaseSelectBuilder.RenderDialect(dialect, parametersCount) + " " + dialect.renderPagination(false, "", "1")
+	if lock := query.lock.renderFor(dialect); len(lock) > 0 {
+		sql += " " + lock
+	}
+
+	return sql
+}
+
+// RenderSQL renders SQL SELECT query limited to single row using standard sql "?"(question) substitutions.
+// Implements RawClauseRenderer.
+func (query SelectSingleBuilder) RenderSQL() (sql string) {
+	return query.RenderDialect(rawDialect, 0)
+}
+
+// Render renders SQL SELECT query limited to single row using "$<number>" substitutions.
+// Implements CountingClauseRenderer.
+func (query SelectSingleBuilder) Render(parametersCount int) (sql string) {
+	return query.RenderDialect(PostgreSQL, parametersCount)
 }
 
 // FieldList returns spec list string with their possible aliases to build select query.
@@ -25,6 +71,7 @@ func (query SelectSingleBuilder) FieldList() string {
 // Fields returns a copy of SelectManyBuilder having mustField list to retrieve updated with a list of specified FieldDefinition`s.
 // Note all fields should be set one step as Fields call resets mustField added before.
 func (query SelectSingleBuilder) Fields(fieldSpecs ...FieldDefinition) (updated SelectSingleBuilder) {
+	updated = query
 	updated.BaseSelectBuilder = updated.BaseSelectBuilder.Fields(fieldSpecs...)
 	return updated
 }
@@ -41,6 +88,77 @@ func (query SelectSingleBuilder) Where(fieldConditions ...Condition) SelectSingl
 	return query
 }
 
+// Distinct returns a copy of SelectSingleBuilder selecting distinct rows only using SQL SELECT DISTINCT.
+func (query SelectSingleBuilder) Distinct() SelectSingleBuilder {
+	query.BaseSelectBuilder = query.BaseSelectBuilder.Distinct()
+	return query
+}
+
+// DistinctOn returns a copy of SelectSingleBuilder selecting the first row of each set of rows
+// having the same values of specified fields using PostgreSQL SELECT DISTINCT ON clause.
+// Ordering should start with the same fields to make the first row predictable.
+// Query build fails for dialects other than PostgreSQL.
+func (query SelectSingleBuilder) DistinctOn(fields ...FieldDefinition) SelectSingleBuilder {
+	query.BaseSelectBuilder = query.BaseSelectBuilder.DistinctOn(fields...)
+	return query
+}
+
+// GroupBy returns a copy of SelectSingleBuilder having rows grouped by specified fields using SQL GROUP BY clause.
+// Note all fields should be set one step as GroupBy call resets grouping fields added before.
+func (query SelectSingleBuilder) GroupBy(fields ...FieldDefinition) SelectSingleBuilder {
+	query.BaseSelectBuilder = query.BaseSelectBuilder.GroupBy(fields...)
+	return query
+}
+
+// Having adds groups conditions rendered as SQL HAVING clause and returns modified SelectSingleBuilder.
+// If any conditions are already added, adds new conditions group joined with logical AND.
+func (query SelectSingleBuilder) Having(groupConditions ...Condition) SelectSingleBuilder {
+	query.having = query.having.GroupAND(groupConditions...)
+	return query
+}
+
+// ForUpdate returns a copy of SelectSingleBuilder locking selected rows for update using SQL FOR UPDATE clause.
+// Note query build fails for dialects not supporting rows locking such as SQLite.
+func (query SelectSingleBuilder) ForUpdate() SelectSingleBuilder {
+	query.lock = query.lock.withStrength(lockForUpdate)
+	return query
+}
+
+// ForNoKeyUpdate returns a copy of SelectSingleBuilder locking selected rows using SQL FOR NO KEY UPDATE clause.
+// Weaker than ForUpdate as it does not block inserts referencing locked rows. Supported by PostgreSQL only.
+func (query SelectSingleBuilder) ForNoKeyUpdate() SelectSingleBuilder {
+	query.lock = query.lock.withStrength(lockForNoKeyUpdate)
+	return query
+}
+
+// ForShare returns a copy of SelectSingleBuilder locking selected rows against updates using SQL FOR SHARE clause.
+// Note query build fails for dialects not supporting shared locks such as SQLite or Oracle.
+func (query SelectSingleBuilder) ForShare() SelectSingleBuilder {
+	query.lock = query.lock.withStrength(lockForShare)
+	return query
+}
+
+// NoWait returns a copy of SelectSingleBuilder failing immediately if any selected row is already locked.
+// Requires one of ForUpdate, ForNoKeyUpdate or ForShare. Overrides SkipLocked.
+func (query SelectSingleBuilder) NoWait() SelectSingleBuilder {
+	query.lock = query.lock.withWait(lockNoWait)
+	return query
+}
+
+// SkipLocked returns a copy of SelectSingleBuilder skipping already locked rows, i.e. to fetch job queue items.
+// Requires one of ForUpdate, ForNoKeyUpdate or ForShare. Overrides NoWait.
+func (query SelectSingleBuilder) SkipLocked() SelectSingleBuilder {
+	query.lock = query.lock.withWait(lockSkipLocked)
+	return query
+}
+
+// Of returns a copy of SelectSingleBuilder locking rows of specified tables only.
+// Use table aliases if tables are aliased in query. Requires one of ForUpdate, ForNoKeyUpdate or ForShare.
+func (query SelectSingleBuilder) Of(tables ...TableName) SelectSingleBuilder {
+	query.lock = query.lock.withTables(tables...)
+	return query
+}
+
 // Update generates table UpdateBuilder.
 // Note generated UpdateBuilder will use only base table even if join conditions added to SelectManyBuilder instance.
 func (query SelectSingleBuilder) Update(values ...FieldValue) UpdateBuilder {
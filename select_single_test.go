@@ -42,3 +42,24 @@ func TestSelectOneFrom(t *testing.T) {
 		})
 	}
 }
+
+func TestSelectSingleBuilder_GroupBy(t *testing.T) {
+	sql, params, err := query.SelectSingleFromBase(query.SelectFrom("orders").Fields(query.Field("customer_id"))).
+		Where(query.EqualTo("status", "paid")).
+		GroupBy(query.Field("customer_id")).
+		Having(query.GreaterThan("SUM(total)", 1000)).
+		BuildQueryAndParamsFor(query.SQLite)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT customer_id FROM orders WHERE status=? GROUP BY customer_id HAVING SUM(total)>? LIMIT 1", sql)
+	require.Equal(t, []any{"paid", 1000}, params)
+}
+
+func TestSelectSingleBuilder_Fields(t *testing.T) {
+	sql, params, err := query.SelectSingleFrom("users").
+		Where(query.EqualTo("id", 1)).
+		Fields(query.Field("name")).
+		BuildQueryAndParams()
+	require.NoError(t, err)
+	require.Equal(t, "SELECT name FROM users WHERE id=$1 LIMIT 1", sql)
+	require.Equal(t, []any{1}, params)
+}
@@ -0,0 +1,328 @@
+package query
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// StructTag defines struct field tag key used to map struct fields to table columns.
+// Tag value starts with column name optionally followed by comma separated options:
+//   - omitempty to skip field having zero value in INSERT and UPDATE queries;
+//   - readonly to never insert or update field, i.e. filled by database defaults or triggers;
+//   - pk to mark primary key field, skipped in INSERT when zero and used as UPDATE condition.
+//
+// Column name "-" excludes field from mapping. Empty column name means field name converted to snake case.
+const StructTag = "db"
+
+var (
+	structColumnsCache sync.Map // map[reflect.Type]structMapping
+	scannerType        = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+	timeType           = reflect.TypeOf(time.Time{})
+)
+
+// StructColumn describes struct field mapped to table column.
+type StructColumn struct {
+	Name       FieldName // column name
+	Index      []int     // field index path suitable for reflect.Value.FieldByIndex, several items for embedded fields
+	OmitEmpty  bool      // skip zero value in INSERT and UPDATE queries
+	ReadOnly   bool      // never insert or update
+	PrimaryKey bool      // primary key field
+	depth      int       // embedding depth, outer struct fields override the same named fields of embedded ones
+}
+
+// structMapping keeps struct type columns list and column positions in it by name.
+type structMapping struct {
+	columns []StructColumn
+	byName  map[FieldName]int
+}
+
+// StructColumns returns a list of columns mapped to fields of specified struct type in fields declaration order.
+// Columns are named by `db:"column"` tag or field name converted to snake case if tag is not set, i.e. UserID to user_id.
+// Untagged embedded structs are flattened, their fields are overridden by the same named outer struct fields.
+// Same named columns of equally deep embedded structs are ambiguous and skipped unless overridden by outer field.
+// Fields tagged `db:"-"`, unexported fields and unexported embedded struct pointers are skipped.
+// Mapping is generated once per struct type and cached. Returns empty list if type is not a mapped struct.
+func StructColumns(structType reflect.Type) []StructColumn {
+	return structMappingOf(structType).columns
+}
+
+// StructColumnByName returns struct column mapped to specified column name or false if there is no such column.
+// See StructColumns for mapping rules.
+func StructColumnByName(structType reflect.Type, name FieldName) (StructColumn, bool) {
+	mapping := structMappingOf(structType)
+	if idx, found := mapping.byName[name]; found {
+		return mapping.columns[idx], true
+	}
+
+	return StructColumn{}, false
+}
+
+// structMappingOf returns cached struct type mapping, generating it on the first call.
+func structMappingOf(structType reflect.Type) structMapping {
+	if cached, found := structColumnsCache.Load(structType); found {
+		return cached.(structMapping)
+	}
+
+	columns := make([]StructColumn, 0)
+	if IsMappedStruct(structType) {
+		columns = dominantStructColumns(collectStructColumns(structType, nil, 0, columns))
+	}
+
+	mapping := structMapping{columns: columns, byName: make(map[FieldName]int, len(columns))}
+	for idx, column := range columns {
+		mapping.byName[column.Name] = idx
+	}
+
+	cached, _ := structColumnsCache.LoadOrStore(structType, mapping)
+
+	return cached.(structMapping)
+}
+
+// IsMappedStruct returns true if type is struct having fields mapped to columns
+// rather than time.Time or sql.Scanner implementation stored in a single column.
+func IsMappedStruct(valueType reflect.Type) bool {
+	return valueType.Kind() == reflect.Struct &&
+		valueType != timeType &&
+		!reflect.PointerTo(valueType).Implements(scannerType)
+}
+
+// collectStructColumns appends columns mapped to specified struct type fields including same named ones.
+func collectStructColumns(structType reflect.Type, index []int, depth int, columns []StructColumn) []StructColumn {
+	for idx := 0; idx < structType.NumField(); idx++ {
+		field := structType.Field(idx)
+		tag, tagged := field.Tag.Lookup(StructTag)
+		name, options, _ := strings.Cut(tag, ",")
+		fieldIndex := append(append(make([]int, 0, len(index)+1), index...), idx)
+
+		if name == "-" {
+			continue
+		}
+
+		embedded := field.Type
+		if embedded.Kind() == reflect.Pointer {
+			embedded = embedded.Elem()
+		}
+
+		if field.Anonymous && !tagged && IsMappedStruct(embedded) {
+			if field.IsExported() || field.Type.Kind() != reflect.Pointer {
+				// unexported struct pointer could not be allocated
+				columns = collectStructColumns(embedded, fieldIndex, depth+1, columns)
+			}
+
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if len(name) == 0 {
+			name = snakeCase(field.Name)
+		}
+
+		column := StructColumn{Name: FieldName(name), Index: fieldIndex, depth: depth}
+
+		for _, option := range strings.Split(options, ",") {
+			switch option {
+			case "omitempty":
+				column.OmitEmpty = true
+			case "readonly":
+				column.ReadOnly = true
+			case "pk":
+				column.PrimaryKey = true
+			}
+		}
+
+		columns = append(columns, column)
+	}
+
+	return columns
+}
+
+// dominantStructColumns returns the least deep embedded column of every name in order of names first appearance.
+// Names having several columns at the least depth are ambiguous and dropped like encoding/json does.
+func dominantStructColumns(candidates []StructColumn) []StructColumn {
+	columns := make([]StructColumn, 0, len(candidates))
+	ambiguous := make(map[FieldName]bool)
+	positions := make(map[FieldName]int, len(candidates))
+
+	for _, column := range candidates {
+		idx, found := positions[column.Name]
+		switch {
+		case !found:
+			positions[column.Name] = len(columns)
+			columns = append(columns, column)
+		case column.depth < columns[idx].depth:
+			columns[idx] = column
+			ambiguous[column.Name] = false
+		case column.depth == columns[idx].depth:
+			ambiguous[column.Name] = true
+		}
+	}
+
+	dominant := columns[:0]
+	for _, column := range columns {
+		if !ambiguous[column.Name] {
+			dominant = append(dominant, column)
+		}
+	}
+
+	return dominant
+}
+
+// snakeCase converts Go field name to snake case column name, i.e. UserID to user_id.
+func snakeCase(name string) string {
+	runes := []rune(name)
+	builder := strings.Builder{}
+
+	for idx, char := range runes {
+		if unicode.IsUpper(char) {
+			lowerBefore := idx > 0 && !unicode.IsUpper(runes[idx-1])
+			lowerAfter := idx > 0 && idx+1 < len(runes) && unicode.IsLower(runes[idx+1])
+
+			if lowerBefore || lowerAfter {
+				builder.WriteByte('_')
+			}
+		}
+
+		builder.WriteRune(unicode.ToLower(char))
+	}
+
+	return builder.String()
+}
+
+// structValue returns struct value of specified struct or struct pointer.
+// Panics if value is neither mapped struct nor non-nil pointer to it.
+func structValue(value any) reflect.Value {
+	reflected := reflect.ValueOf(value)
+	if reflected.Kind() == reflect.Pointer && !reflected.IsNil() {
+		reflected = reflected.Elem()
+	}
+
+	if !reflected.IsValid() || !IsMappedStruct(reflected.Type()) {
+		panic(fmt.Errorf("%w: struct or non-nil struct pointer expected, not %T", Error, value))
+	}
+
+	return reflected
+}
+
+// structFieldValue returns struct field value by index path or false if field is a part of nil embedded struct pointer.
+func structFieldValue(value reflect.Value, index []int) (reflect.Value, bool) {
+	for pos, idx := range index {
+		if pos > 0 && value.Kind() == reflect.Pointer {
+			if value.IsNil() {
+				return reflect.Value{}, false
+			}
+
+			value = value.Elem()
+		}
+
+		value = value.Field(idx)
+	}
+
+	return value, true
+}
+
+// structValues returns FieldValue list of struct columns values.
+// Takes include function deciding if column having specified value should be included.
+func structValues(value any, include func(column StructColumn, value reflect.Value) bool) []FieldValue {
+	reflected := structValue(value)
+	values := make([]FieldValue, 0)
+
+	for _, column := range StructColumns(reflected.Type()) {
+		fieldValue, found := structFieldValue(reflected, column.Index)
+		if !found || !include(column, fieldValue) {
+			continue
+		}
+
+		values = append(values, column.Name.Value(fieldValue.Interface()))
+	}
+
+	return values
+}
+
+// StructFields returns a list of FieldDefinition of all struct columns to use in select queries,
+// i.e. SelectManyFrom("users").Fields(StructFields(User{})...).
+// Takes struct or struct pointer. Panics if any other value specified.
+func StructFields(value any) []FieldDefinition {
+	columns := StructColumns(structValue(value).Type())
+	fields := make([]FieldDefinition, len(columns))
+
+	for idx, column := range columns {
+		fields[idx] = Field(column.Name)
+	}
+
+	return fields
+}
+
+// FromStruct generates new InsertBuilder having field values taken from struct columns, see StructTag for mapping.
+// Read only columns are skipped as well as omitempty and primary key columns having zero values.
+// Takes struct or struct pointer. Panics if any other value specified.
+func (inserter InsertBuilder) FromStruct(value any) InsertBuilder {
+	return inserter.Values(structValues(value, func(column StructColumn, value reflect.Value) bool {
+		return !column.ReadOnly && !((column.OmitEmpty || column.PrimaryKey) && value.IsZero())
+	})...)
+}
+
+// FromStructNonZero generates new InsertBuilder having non-zero field values taken from struct columns.
+// Read only columns are skipped too. Takes struct or struct pointer. Panics if any other value specified.
+func (inserter InsertBuilder) FromStructNonZero(value any) InsertBuilder {
+	return inserter.Values(structValues(value, func(column StructColumn, value reflect.Value) bool {
+		return !column.ReadOnly && !value.IsZero()
+	})...)
+}
+
+// SetStruct generates new UpdateBuilder having fields to update taken from struct columns, see StructTag for mapping.
+// If onlyFields specified, only listed columns are updated. Read only columns and omitempty ones having zero values
+// are skipped. Primary key columns are never updated, their values are added to WHERE conditions instead.
+// Takes struct or struct pointer. Panics if any other value specified.
+func (updater UpdateBuilder) SetStruct(value any, onlyFields ...FieldName) UpdateBuilder {
+	return updater.setStruct(value, false, onlyFields)
+}
+
+// SetStructNonZero generates new UpdateBuilder having fields to update taken from struct columns
+// having non-zero values only. Other rules are the same as of SetStruct.
+// Takes struct or struct pointer. Panics if any other value specified.
+func (updater UpdateBuilder) SetStructNonZero(value any, onlyFields ...FieldName) UpdateBuilder {
+	return updater.setStruct(value, true, onlyFields)
+}
+
+// setStruct generates new UpdateBuilder having fields to update and primary key conditions taken from struct columns.
+func (updater UpdateBuilder) setStruct(value any, skipZero bool, onlyFields []FieldName) UpdateBuilder {
+	conditions := make([]Condition, 0)
+
+	for _, pk := range structValues(value, func(column StructColumn, _ reflect.Value) bool { return column.PrimaryKey }) {
+		conditions = append(conditions, EqualTo(FieldName(pk.fieldName), pk.value))
+	}
+
+	values := structValues(value, func(column StructColumn, value reflect.Value) bool {
+		switch {
+		case column.ReadOnly, column.PrimaryKey:
+			return false
+		case (skipZero || column.OmitEmpty) && value.IsZero():
+			return false
+		case len(onlyFields) == 0:
+			return true
+		}
+
+		for _, field := range onlyFields {
+			if field == column.Name {
+				return true
+			}
+		}
+
+		return false
+	})
+
+	updated := updater.Set(values...)
+	if len(conditions) > 0 {
+		updated = updated.Where(conditions...)
+	}
+
+	return updated
+}
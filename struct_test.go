@@ -0,0 +1,189 @@
+package query_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/query"
+)
+
+type timestamps struct {
+	CreatedAt time.Time `db:"created_at,readonly"`
+	UpdatedAt time.Time
+}
+
+type structUser struct {
+	timestamps
+	ID       int64   `db:"id,pk"`
+	UserName string  `db:"login"`
+	Email    string  `db:",omitempty"`
+	Nickname *string `db:"nick"`
+	Secret   string  `db:"-"`
+	hidden   string
+}
+
+func TestStructColumns(t *testing.T) {
+	columns := query.StructColumns(reflect.TypeOf(structUser{}))
+	names := make([]query.FieldName, len(columns))
+
+	for idx, column := range columns {
+		names[idx] = column.Name
+	}
+
+	require.Equal(t, []query.FieldName{"created_at", "updated_at", "id", "login", "email", "nick"}, names)
+	require.True(t, columns[0].ReadOnly)
+	require.Equal(t, []int{0, 1}, columns[1].Index)
+	require.True(t, columns[2].PrimaryKey)
+	require.True(t, columns[4].OmitEmpty)
+	require.Empty(t, query.StructColumns(reflect.TypeOf(time.Time{})))
+}
+
+type structNamed struct {
+	Name string
+	Note string
+}
+
+type structTitled struct {
+	Name  string
+	Title string
+}
+
+type structDeepTitled struct {
+	structTitled
+}
+
+func TestStructColumns_Ambiguous(t *testing.T) {
+	columnNames := func(value any) []query.FieldName {
+		names := make([]query.FieldName, 0)
+		for _, column := range query.StructColumns(reflect.TypeOf(value)) {
+			names = append(names, column.Name)
+		}
+
+		return names
+	}
+
+	require.Equal(t, []query.FieldName{"note", "title"}, columnNames(struct {
+		structNamed
+		structTitled
+	}{}))
+
+	overridden := query.StructColumns(reflect.TypeOf(struct {
+		structNamed
+		structTitled
+		Name string
+	}{}))
+	require.Len(t, overridden, 3)
+	require.Equal(t, query.FieldName("name"), overridden[0].Name)
+	require.Equal(t, []int{2}, overridden[0].Index)
+
+	deeper := query.StructColumns(reflect.TypeOf(struct {
+		structNamed
+		structDeepTitled
+	}{}))
+	require.Len(t, deeper, 3)
+	require.Equal(t, query.FieldName("name"), deeper[0].Name)
+	require.Equal(t, []int{0, 0}, deeper[0].Index)
+}
+
+func TestStructColumnByName(t *testing.T) {
+	column, found := query.StructColumnByName(reflect.TypeOf(structUser{}), "login")
+	require.True(t, found)
+	require.Equal(t, []int{2}, column.Index)
+
+	column, found = query.StructColumnByName(reflect.TypeOf(structUser{}), "updated_at")
+	require.True(t, found)
+	require.Equal(t, []int{0, 1}, column.Index)
+
+	_, found = query.StructColumnByName(reflect.TypeOf(structUser{}), "secret")
+	require.False(t, found)
+	_, found = query.StructColumnByName(reflect.TypeOf(time.Time{}), "wall")
+	require.False(t, found)
+}
+
+func TestStructFields(t *testing.T) {
+	sql, _, err := query.SelectManyFrom("users").Fields(query.StructFields(&structUser{})...).BuildQueryAndParams()
+	require.NoError(t, err)
+	require.Equal(t, "SELECT created_at, updated_at, id, login, email, nick FROM users", sql)
+	require.Panics(t, func() { query.StructFields(42) })
+}
+
+func TestInsertBuilder_FromStruct(t *testing.T) {
+	updated := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	user := structUser{timestamps: timestamps{CreatedAt: updated, UpdatedAt: updated}, UserName: "alice"}
+
+	tests := []struct {
+		name       string
+		builder    query.InsertBuilder
+		wantSQL    string
+		wantParams []any
+	}{
+		{
+			"skip_zero_pk_and_omitempty",
+			query.InsertInto("users").FromStruct(user),
+			"INSERT INTO users(updated_at, login, nick) VALUES ($1, $2, $3)",
+			[]any{updated, "alice", (*string)(nil)},
+		},
+		{
+			"keep_set_pk",
+			query.InsertInto("users").FromStruct(&structUser{ID: 7, Email: "a@example.com"}),
+			"INSERT INTO users(updated_at, id, login, email, nick) VALUES ($1, $2, $3, $4, $5)",
+			[]any{time.Time{}, int64(7), "", "a@example.com", (*string)(nil)},
+		},
+		{
+			"non_zero",
+			query.InsertInto("users").FromStructNonZero(user),
+			"INSERT INTO users(updated_at, login) VALUES ($1, $2)",
+			[]any{updated, "alice"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, params, err := tt.builder.BuildQueryAndParams()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSQL, sql)
+			require.Equal(t, tt.wantParams, params)
+		})
+	}
+}
+
+func TestUpdateBuilder_SetStruct(t *testing.T) {
+	nick := "al"
+	user := structUser{ID: 3, UserName: "alice", Nickname: &nick}
+
+	tests := []struct {
+		name       string
+		builder    query.UpdateBuilder
+		wantSQL    string
+		wantParams []any
+	}{
+		{
+			"all",
+			query.Update("users").SetStruct(user),
+			"UPDATE users SET updated_at=$1, login=$2, nick=$3 WHERE id=$4",
+			[]any{time.Time{}, "alice", &nick, int64(3)},
+		},
+		{
+			"only_fields",
+			query.Update("users").SetStruct(&user, "login", "email", "id"),
+			"UPDATE users SET login=$1 WHERE id=$2",
+			[]any{"alice", int64(3)},
+		},
+		{
+			"non_zero",
+			query.Update("users").SetStructNonZero(user),
+			"UPDATE users SET login=$1, nick=$2 WHERE id=$3",
+			[]any{"alice", &nick, int64(3)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql, params, err := tt.builder.BuildQueryAndParams()
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSQL, sql)
+			require.Equal(t, tt.wantParams, params)
+		})
+	}
+}
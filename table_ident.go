@@ -109,11 +109,17 @@ func (tableIdent TableIdent) Alias() TableName {
 // The result is "<name>" or "<name> AS <alias>".
 // Implements ClauseFromRenderer.
 func (tableIdent TableIdent) RenderFrom() string {
+	return tableIdent.RenderFromFor(PostgreSQL)
+}
+
+// RenderFromFor render table identification string to fill SQL FROM clause.
+// Table name and alias are quoted according to specified Dialect quoting mode.
+func (tableIdent TableIdent) RenderFromFor(dialect Dialect) string {
 	switch {
 	case len(tableIdent.alias) > 0 && tableIdent.alias != tableIdent.name:
-		return tableIdent.name + " AS " + tableIdent.alias
+		return dialect.QuoteIdent(tableIdent.name) + " AS " + dialect.QuoteIdent(tableIdent.alias)
 	default:
-		return tableIdent.name
+		return dialect.QuoteIdent(tableIdent.name)
 	}
 }
 
@@ -43,6 +43,11 @@ func (tableName TableName) RenderFrom() string {
 	return string(tableName)
 }
 
+// RenderFromFor returns TableName string value quoted according to specified Dialect quoting mode.
+func (tableName TableName) RenderFromFor(dialect Dialect) string {
+	return dialect.QuoteIdent(string(tableName))
+}
+
 // Select generates BaseSelectBuilder helper.
 // Condition's will be used to filter values if specified.
 // To get SelectSingleBuilder or SelectManyBuilder directly use SelectSingleFrom or SelectManyFrom instead.
@@ -2,7 +2,6 @@ package query
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
 )
 
@@ -12,6 +11,7 @@ type UpdateBuilder struct {
 	tableName TableName
 	where     Group
 	setValues []FieldValue
+	returning returningClause
 }
 
 // RenderFrom returns string representation of table name or tables join with possible tables aliases.
@@ -37,22 +37,44 @@ func (updater UpdateBuilder) Where(fieldConditions ...Condition) UpdateBuilder {
 	return updater
 }
 
-func (updater UpdateBuilder) fieldsAndValues() (sql string, params []any) {
+// Returning generates new UpdateBuilder requesting updated rows fields using SQL RETURNING clause.
+// Fields are rendered with their aliases. If no fields specified, all fields are returned.
+// Note query build fails for dialects not supporting RETURNING clause.
+func (updater UpdateBuilder) Returning(fields ...FieldDefinition) UpdateBuilder {
+	updater.returning = updater.returning.Fields(fields...)
+	return updater
+}
+
+// fieldsAndValues renders SQL UPDATE SET clause using specified Dialect placeholders and returns its parameters.
+func (updater UpdateBuilder) fieldsAndValues(dialect Dialect) (sql string, params []any) {
 	kwPairs := make([]string, len(updater.setValues))
 	params = make([]any, len(updater.setValues))
 
 	for idx, fieldValue := range updater.setValues {
 		params[idx] = fieldValue.Values()[0]
-		kwPairs[idx] = fieldValue.fieldName + "=$" + strconv.Itoa(idx+1)
+		kwPairs[idx] = dialect.QuoteIdent(fieldValue.fieldName) + "=" + dialect.Placeholder(idx+1)
 	}
 
 	return strings.Join(kwPairs, ", "), params
 }
 
+// String returns debug representation of UpdateBuilder query having parameters inlined, see DebugSQL.
+// Implements fmt.Stringer.
+func (updater UpdateBuilder) String() string {
+	return DebugSQL(updater)
+}
+
 // BuildQueryAndParams returns query string and params to fill in SQL UPDATE query string.
 // If query build failed returns non-nil error.
+// Uses PostgreSQL "$N" placeholders, use BuildQueryAndParamsFor to generate query for another Dialect.
 func (updater UpdateBuilder) BuildQueryAndParams() (sql string, params []interface{}, err error) {
-	var fieldsEnum string
+	return updater.BuildQueryAndParamsFor(PostgreSQL)
+}
+
+// BuildQueryAndParamsFor returns query string and params to fill in SQL UPDATE query string using specified Dialect.
+// If query build failed returns non-nil error.
+func (updater UpdateBuilder) BuildQueryAndParamsFor(dialect Dialect) (sql string, params []interface{}, err error) {
+	var fieldsEnum, returning string
 
 	// disallow some cases
 	switch {
@@ -64,19 +86,27 @@ func (updater UpdateBuilder) BuildQueryAndParams() (sql string, params []interfa
 		return "", params, fmt.Errorf("%w: no table name set", Error)
 	}
 
-	fieldsEnum, params = updater.fieldsAndValues()
-	sql = strings.Join([]string{
+	if returning, err = updater.returning.RenderFor(dialect); err != nil {
+		return "", params, err
+	}
+
+	fieldsEnum, params = updater.fieldsAndValues(dialect)
+	tokens := []string{
 		kwUpdate.String(),
-		updater.tableName.String(),
+		updater.tableName.RenderFromFor(dialect),
 		kwSet.String(),
 		fieldsEnum,
 		kwWhere.String(),
-		updater.where.Render(len(params)),
-	}, " ")
+		updater.where.RenderDialect(dialect, len(params)),
+	}
+
+	if len(returning) > 0 {
+		tokens = append(tokens, returning)
+	}
 
-	params = append(params, updater.where.Values()...)
+	params = append(params, updater.where.ValuesFor(dialect)...)
 
-	return sql, params, nil
+	return strings.Join(tokens, " "), params, nil
 }
 
 // TableName returns table name to update.
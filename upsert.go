@@ -0,0 +1,166 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+)
+
+// conflictAction defines an action to take on insert conflict.
+type conflictAction int
+
+const (
+	conflictNotSet    conflictAction = iota // no ON CONFLICT clause requested
+	conflictDoNothing                       // skip conflicting rows
+	conflictDoUpdate                        // update conflicting rows
+)
+
+// excludedValue marks FieldValue value to take from the row proposed for insertion.
+type excludedValue struct{}
+
+// Excluded generates FieldValue to use in conflict update action of InsertBuilder.
+// It sets field value to the value proposed for insertion, i.e. renders "<field>=EXCLUDED.<field>"
+// for PostgreSQL or SQLite and "<field>=VALUES(<field>)" for MySQL.
+func Excluded(fieldName FieldName) FieldValue {
+	return *NewFieldValue(fieldName, excludedValue{})
+}
+
+// onConflictClause stores SQL INSERT conflict resolution settings.
+// Rendered as ON CONFLICT clause for PostgreSQL or SQLite and as ON DUPLICATE KEY UPDATE for MySQL.
+type onConflictClause struct {
+	action    conflictAction
+	target    []FieldDefinition // conflicting fields, ignored by MySQL
+	setValues []FieldValue      // fields to update on conflict
+	where     Group             // update action conditions
+}
+
+// valuesFor returns a set of parameters to substitute in clause rendered with specified Dialect.
+// Excluded values are rendered in place so never included.
+func (clause onConflictClause) valuesFor(dialect Dialect) (params []any) {
+	params = make([]any, 0)
+
+	for _, fieldValue := range clause.setValues {
+		if _, isExcluded := fieldValue.value.(excludedValue); !isExcluded {
+			params = append(params, fieldValue.Values()...)
+		}
+	}
+
+	if clause.action == conflictDoUpdate {
+		params = append(params, clause.where.ValuesFor(dialect)...)
+	}
+
+	return params
+}
+
+// validate returns error if clause settings could not be rendered using specified Dialect.
+func (clause onConflictClause) validate(dialect Dialect) error {
+	switch {
+	case clause.action != conflictDoUpdate && len(clause.where.conditions) > 0:
+		return fmt.Errorf("%w: update conditions set without update on conflict", Error)
+	case clause.action == conflictNotSet:
+		return nil
+	case dialect.kind != kindPostgreSQL && dialect.kind != kindSQLite && dialect.kind != kindMySQL:
+		return fmt.Errorf("%w: %v dialect does not support insert conflicts resolution", Error, dialect)
+	case clause.action == conflictDoUpdate && len(clause.setValues) == 0:
+		return fmt.Errorf("%w: no fields to update on conflict", Error)
+	case clause.action == conflictDoUpdate && len(clause.target) == 0 && dialect.kind != kindMySQL:
+		return fmt.Errorf("%w: no conflict fields set to update on conflict", Error)
+	case len(clause.where.conditions) > 0 && dialect.kind == kindMySQL:
+		return fmt.Errorf("%w: %v dialect does not support conditional update on conflict", Error, dialect)
+	}
+
+	return nil
+}
+
+// RenderDialect renders conflict resolution clause using specified Dialect placeholders.
+// Takes existed parameters count and insert columns list, the first column is used to render MySQL no-op update.
+// Returns empty string if no conflict resolution requested.
+func (clause onConflictClause) RenderDialect(dialect Dialect, parametersCount int, columns []string) string {
+	if clause.action == conflictNotSet {
+		return ""
+	}
+
+	if dialect.kind == kindMySQL {
+		if clause.action == conflictDoNothing { // no-op update keeps existed row intact
+			column := dialect.QuoteIdent(columns[0])
+			return "ON DUPLICATE KEY UPDATE " + column + "=" + column
+		}
+
+		return "ON DUPLICATE KEY UPDATE " + clause.renderSet(dialect, parametersCount)
+	}
+
+	tokens := []string{kwOn.String(), "CONFLICT"}
+
+	if len(clause.target) > 0 {
+		targetFields := make([]string, len(clause.target))
+		for idx, field := range clause.target {
+			targetFields[idx] = dialect.QuoteIdent(field.fieldName)
+		}
+
+		tokens = append(tokens, "("+strings.Join(targetFields, ", ")+")")
+	}
+
+	if clause.action == conflictDoNothing {
+		return strings.Join(append(tokens, "DO NOTHING"), " ")
+	}
+
+	tokens = append(tokens, "DO", kwUpdate.String(), kwSet.String(), clause.renderSet(dialect, parametersCount))
+
+	if len(clause.where.conditions) > 0 {
+		for _, fieldValue := range clause.setValues {
+			if _, isExcluded := fieldValue.value.(excludedValue); !isExcluded {
+				parametersCount += len(fieldValue.Values())
+			}
+		}
+
+		tokens = append(tokens, kwWhere.String(), clause.where.RenderDialect(dialect, parametersCount))
+	}
+
+	return strings.Join(tokens, " ")
+}
+
+// renderSet renders update action fields assignments.
+func (clause onConflictClause) renderSet(dialect Dialect, parametersCount int) string {
+	assignments := make([]string, len(clause.setValues))
+
+	for idx, fieldValue := range clause.setValues {
+		column := dialect.QuoteIdent(fieldValue.fieldName)
+
+		switch _, isExcluded := fieldValue.value.(excludedValue); {
+		case isExcluded && dialect.kind == kindMySQL:
+			assignments[idx] = column + "=VALUES(" + column + ")"
+		case isExcluded:
+			assignments[idx] = column + "=EXCLUDED." + column
+		default:
+			parametersCount += len(fieldValue.Values())
+			assignments[idx] = column + "=" + dialect.Placeholder(parametersCount)
+		}
+	}
+
+	return strings.Join(assignments, ", ")
+}
+
+// incompleteInsertConflict implements IncompleteInsertConflict preventing direct instance usage.
+// The goal is to provide chain style method to generate conflict resolution clause.
+type incompleteInsertConflict struct {
+	InsertBuilder
+}
+
+// DoNothing finalises conflict resolution definition to skip conflicting rows.
+// Returns updated InsertBuilder instance.
+func (conflict incompleteInsertConflict) DoNothing() (updated InsertBuilder) {
+	updated = conflict.InsertBuilder
+	updated.onConflict.action = conflictDoNothing
+
+	return updated
+}
+
+// DoUpdate finalises conflict resolution definition to update conflicting rows with specified values.
+// Use Excluded to set field to the value proposed for insertion.
+// Returns updated InsertBuilder instance.
+func (conflict incompleteInsertConflict) DoUpdate(values ...FieldValue) (updated InsertBuilder) {
+	updated = conflict.InsertBuilder
+	updated.onConflict.action = conflictDoUpdate
+	updated.onConflict.setValues = append(make([]FieldValue, 0, len(values)), values...)
+
+	return updated
+}
@@ -0,0 +1,146 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/query"
+)
+
+func TestInsertBuilder_OnConflict(t *testing.T) {
+	insert := query.InsertInto("users").Values(
+		query.FieldName("email").Value("a@b.c"),
+		query.FieldName("name").Value("A"),
+	)
+
+	tests := []struct {
+		name       string
+		builder    query.QueryBuilder
+		dialect    query.Dialect
+		wantSQL    string
+		wantParams []any
+		wantErr    bool
+	}{
+		{
+			"do_nothing",
+			insert.OnConflict(query.Field("email")).DoNothing(),
+			query.PostgreSQL,
+			"INSERT INTO users(email, name) VALUES ($1, $2) ON CONFLICT (email) DO NOTHING",
+			[]any{"a@b.c", "A"},
+			false,
+		},
+		{
+			"do_nothing_any_conflict",
+			insert.OnConflict().DoNothing(),
+			query.SQLite,
+			"INSERT INTO users(email, name) VALUES (?, ?) ON CONFLICT DO NOTHING",
+			[]any{"a@b.c", "A"},
+			false,
+		},
+		{
+			"do_nothing_mysql",
+			insert.OnConflict(query.Field("email")).DoNothing(),
+			query.MySQL,
+			"INSERT INTO users(email, name) VALUES (?, ?) ON DUPLICATE KEY UPDATE email=email",
+			[]any{"a@b.c", "A"},
+			false,
+		},
+		{
+			"do_update_excluded_and_value",
+			insert.OnConflict(query.Field("email")).DoUpdate(
+				query.Excluded("name"),
+				query.FieldName("visits").Value(1),
+			),
+			query.PostgreSQL,
+			"INSERT INTO users(email, name) VALUES ($1, $2) " +
+				"ON CONFLICT (email) DO UPDATE SET name=EXCLUDED.name, visits=$3",
+			[]any{"a@b.c", "A", 1},
+			false,
+		},
+		{
+			"do_update_where_returning",
+			insert.OnConflict(query.Field("email")).
+				DoUpdate(query.FieldName("visits").Value(1), query.Excluded("name")).
+				DoUpdateWhere(query.Less("users.version", 5)).
+				Returning(query.Field("id")),
+			query.PostgreSQL,
+			"INSERT INTO users(email, name) VALUES ($1, $2) " +
+				"ON CONFLICT (email) DO UPDATE SET visits=$3, name=EXCLUDED.name WHERE users.version<$4 RETURNING id",
+			[]any{"a@b.c", "A", 1, 5},
+			false,
+		},
+		{
+			"do_update_mysql",
+			insert.OnConflict(query.Field("email")).DoUpdate(
+				query.Excluded("name"),
+				query.FieldName("visits").Value(1),
+			),
+			query.MySQL.WithQuoting(query.QuoteAlways),
+			"INSERT INTO `users`(`email`, `name`) VALUES (?, ?) " +
+				"ON DUPLICATE KEY UPDATE `name`=VALUES(`name`), `visits`=?",
+			[]any{"a@b.c", "A", 1},
+			false,
+		},
+		{
+			"do_update_where_mysql",
+			insert.OnConflict(query.Field("email")).DoUpdate(query.Excluded("name")).
+				DoUpdateWhere(query.Less("version", 5)),
+			query.MySQL,
+			"", nil, true,
+		},
+		{
+			"do_update_without_target",
+			insert.OnConflict().DoUpdate(query.Excluded("name")),
+			query.PostgreSQL,
+			"", nil, true,
+		},
+		{
+			"do_update_without_values",
+			insert.OnConflict(query.Field("email")).DoUpdate(),
+			query.PostgreSQL,
+			"", nil, true,
+		},
+		{
+			"where_without_do_update",
+			insert.OnConflict(query.Field("email")).DoNothing().DoUpdateWhere(query.Less("version", 5)),
+			query.PostgreSQL,
+			"", nil, true,
+		},
+		{
+			"sql_server",
+			insert.OnConflict(query.Field("email")).DoNothing(),
+			query.SQLServer,
+			"", nil, true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSQL, gotParams, err := tt.builder.BuildQueryAndParamsFor(tt.dialect)
+			require.Equalf(t, tt.wantErr, err != nil, "want error %v, got %v", tt.wantErr, err)
+			if err != nil {
+				return
+			}
+			require.Equal(t, tt.wantSQL, gotSQL)
+			require.Equal(t, tt.wantParams, gotParams)
+		})
+	}
+}
+
+func TestInsertBuilder_OnConflict_Batches(t *testing.T) {
+	statements, err := query.InsertInto("t1").
+		Rows(query.ValuesMap{"id": 1}, query.ValuesMap{"id": 2}, query.ValuesMap{"id": 3}).
+		OnConflict(query.Field("id")).DoUpdate(query.FieldName("touched").Value(true)).
+		BuildStatementsFor(query.PostgreSQL.WithMaxParameters(3))
+	require.NoError(t, err)
+	require.Equal(t, []query.Statement{
+		{
+			SQL:    "INSERT INTO t1(id) VALUES ($1), ($2) ON CONFLICT (id) DO UPDATE SET touched=$3",
+			Params: []any{1, 2, true},
+		},
+		{
+			SQL:    "INSERT INTO t1(id) VALUES ($1) ON CONFLICT (id) DO UPDATE SET touched=$2",
+			Params: []any{3, true},
+		},
+	}, statements)
+}
@@ -43,6 +43,13 @@ func (query WhereClause) Conditions() (conditions []Condition) {
 	return conditions
 }
 
+// RenderDialect renders SQL SELECT clause part for current group using specified Dialect placeholders.
+// Takes existed parameters number (0 means no parameters are defined yet)
+// Implements DialectClauseRenderer.
+func (query WhereClause) RenderDialect(dialect Dialect, parametersCount int) (sql string) {
+	return query.group.RenderDialect(dialect, parametersCount)
+}
+
 // Render renders SQL SELECT clause part for current group.
 // Takes existed parameters number (0 means no parameters are defined yet)
 // Note Render renders group without brackets itself if called directly.
@@ -63,6 +70,12 @@ func (query WhereClause) Values() (values []interface{}) {
 	return query.group.Values()
 }
 
+// ValuesFor returns a set of values of grouped conditions ordered as specified Dialect expects.
+// Implements DialectValuesProvider.
+func (query WhereClause) ValuesFor(dialect Dialect) (values []any) {
+	return query.group.ValuesFor(dialect)
+}
+
 // String returns a string representation of WhereClause.
 func (query WhereClause) String() string {
 	return "Where(" + query.group.RenderSQL() + ")"
@@ -0,0 +1,112 @@
+package query
+
+import (
+	"strings"
+)
+
+// Window defines window specification of window function call, i.e. OVER (PARTITION BY ... ORDER BY ...).
+// Zero value Window defines the whole result set as single partition without ordering.
+// Window is immutable, PartitionBy and OrderBy return an updated copy.
+type Window struct {
+	partitionBy []FieldDefinition // fields to split rows into partitions
+	orderBy     []FieldSorting    // rows ordering inside partition
+}
+
+// PartitionBy returns a copy of Window having rows split into partitions by specified fields.
+// Note all fields should be set one step as PartitionBy call resets partitioning fields added before.
+func (window Window) PartitionBy(fields ...FieldDefinition) Window {
+	window.partitionBy = append(make([]FieldDefinition, 0, len(fields)), fields...)
+	return window
+}
+
+// OrderBy returns a copy of Window having additional rows ordering inside partition.
+func (window Window) OrderBy(orderByFields ...FieldSorting) Window {
+	window.orderBy = append(append(make([]FieldSorting, 0, len(window.orderBy)), window.orderBy...), orderByFields...)
+	return window
+}
+
+// renderFor renders window specification enclosed into brackets using specified Dialect quoting mode.
+func (window Window) renderFor(dialect Dialect) string {
+	tokens := make([]string, 0, 4)
+
+	if len(window.partitionBy) > 0 {
+		fields := make([]string, len(window.partitionBy))
+		for idx, field := range window.partitionBy {
+			fields[idx] = field.renderOperandFor(dialect)
+		}
+
+		tokens = append(tokens, "PARTITION BY", strings.Join(fields, ", "))
+	}
+
+	if len(window.orderBy) > 0 {
+		fields := make([]string, len(window.orderBy))
+		for idx, order := range window.orderBy {
+			fields[idx] = order.RenderFor(dialect)
+		}
+
+		tokens = append(tokens, "ORDER BY", strings.Join(fields, ", "))
+	}
+
+	return "(" + strings.Join(tokens, " ") + ")"
+}
+
+// PartitionBy makes Window having rows split into partitions by specified fields.
+// Use Window.OrderBy to set rows ordering inside partition.
+func PartitionBy(fields ...FieldDefinition) Window {
+	return Window{}.PartitionBy(fields...)
+}
+
+// Over returns a copy of expression FieldDefinition computed over specified Window,
+// i.e. Sum("amount").Over(PartitionBy(Field("user_id"))) renders SUM(amount) OVER (PARTITION BY user_id).
+// Alias is kept. Plain fields are returned unchanged as only function calls could be windowed.
+func (fieldIdent FieldDefinition) Over(window Window) FieldDefinition {
+	if fieldIdent.expr == nil || len(fieldIdent.expr.function) == 0 {
+		return fieldIdent
+	}
+
+	expr := *fieldIdent.expr
+	expr.window = &window
+
+	return expressionField(expr).As(FieldName(fieldIdent.alias))
+}
+
+// RowNumber generates ROW_NUMBER() window function expression numbering rows inside Window partition.
+// Use FieldDefinition.As to set result alias.
+func RowNumber(window Window) FieldDefinition {
+	return functionField("ROW_NUMBER").Over(window)
+}
+
+// Rank generates RANK() window function expression ranking rows inside Window partition with gaps.
+// Use FieldDefinition.As to set result alias.
+func Rank(window Window) FieldDefinition {
+	return functionField("RANK").Over(window)
+}
+
+// DenseRank generates DENSE_RANK() window function expression ranking rows inside Window partition without gaps.
+// Use FieldDefinition.As to set result alias.
+func DenseRank(window Window) FieldDefinition {
+	return functionField("DENSE_RANK").Over(window)
+}
+
+// Lag generates LAG(field, offset) window function expression taking field value of the row
+// located offset rows before current one inside Window partition.
+// Takes string, FieldName or FieldDefinition. Use FieldDefinition.As to set result alias.
+func Lag[T FieldNameParameter](field T, offset uint, window Window) FieldDefinition {
+	return functionField("LAG", Field(field), Literal(offset)).Over(window)
+}
+
+// Lead generates LEAD(field, offset) window function expression taking field value of the row
+// located offset rows after current one inside Window partition.
+// Takes string, FieldName or FieldDefinition. Use FieldDefinition.As to set result alias.
+func Lead[T FieldNameParameter](field T, offset uint, window Window) FieldDefinition {
+	return functionField("LEAD", Field(field), Literal(offset)).Over(window)
+}
+
+// renderWindowFor renders OVER clause of windowed expression or empty string if expression is not windowed.
+func (expr expression) renderWindowFor(dialect Dialect) string {
+	if expr.window == nil {
+		return ""
+	}
+
+	return " OVER " + expr.window.renderFor(dialect)
+}
@@ -0,0 +1,92 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/query"
+)
+
+func TestWindowFunctions_RenderSpecFor(t *testing.T) {
+	byUser := query.PartitionBy(query.Field("user_id")).OrderBy(query.DESC("created_at"))
+
+	tests := []struct {
+		name    string
+		field   query.FieldDefinition
+		dialect query.Dialect
+		want    string
+	}{
+		{
+			"row_number",
+			query.RowNumber(byUser).As("rn"),
+			query.PostgreSQL,
+			"ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY created_at DESC) AS rn",
+		},
+		{
+			"rank_order_only",
+			query.Rank(query.Window{}.OrderBy(query.DESC("score"))),
+			query.MySQL,
+			"RANK() OVER (ORDER BY score DESC)",
+		},
+		{
+			"dense_rank_several_partitions",
+			query.DenseRank(query.PartitionBy(query.Field("t.game"), query.Field("t.level")).OrderBy(query.DESC("t.score"))),
+			query.SQLite,
+			"DENSE_RANK() OVER (PARTITION BY t.game, t.level ORDER BY t.score DESC)",
+		},
+		{
+			"lag",
+			query.Lag("amount", 1, byUser).As("previous"),
+			query.PostgreSQL,
+			"LAG(amount, 1) OVER (PARTITION BY user_id ORDER BY created_at DESC) AS previous",
+		},
+		{
+			"lead",
+			query.Lead(query.Field("amount"), 2, query.Window{}),
+			query.PostgreSQL,
+			"LEAD(amount, 2) OVER ()",
+		},
+		{
+			"windowed_sum_keeps_alias",
+			query.Sum("amount").As("running").Over(byUser),
+			query.PostgreSQL,
+			"SUM(amount) OVER (PARTITION BY user_id ORDER BY created_at DESC) AS running",
+		},
+		{
+			"windowed_count_quoted",
+			query.CountOf().Over(query.PartitionBy(query.Field("group"))).As("total"),
+			query.MySQL.WithQuoting(query.QuoteNeeded),
+			"COUNT(*) OVER (PARTITION BY `group`) AS total",
+		},
+		{
+			"plain_field_not_windowed",
+			query.Field("amount").Over(byUser),
+			query.PostgreSQL,
+			"amount",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.field.RenderSpecFor(tt.dialect))
+		})
+	}
+}
+
+func TestWindowFunctions_LatestRowPerGroup(t *testing.T) {
+	ranked := query.SelectFrom("events").Fields(
+		query.Field("user_id"),
+		query.Field("payload"),
+		query.RowNumber(query.PartitionBy(query.Field("user_id")).OrderBy(query.DESC("created_at"))).As("rn"),
+	).Where(query.EqualTo("kind", "login"))
+
+	sql, params, err := query.With("ranked", ranked).
+		Select(query.SelectFrom("ranked").Fields(query.Field("user_id"), query.Field("payload")).
+			Where(query.EqualTo("rn", 1))).
+		BuildQueryAndParams()
+	require.NoError(t, err)
+	require.Equal(t, "WITH ranked AS (SELECT user_id, payload, "+
+		"ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY created_at DESC) AS rn FROM events WHERE kind=$1) "+
+		"SELECT user_id, payload FROM ranked WHERE rn=$2", sql)
+	require.Equal(t, []any{"login", 1}, params)
+}
@@ -0,0 +1,176 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+)
+
+// commonTable defines single common table expression of SQL WITH clause.
+type commonTable struct {
+	name      TableName // name to refer expression results as table
+	query     SubQuery  // expression query or anchor member of recursive expression
+	recursive SubQuery  // recursive member combined with anchor by UNION ALL, nil if expression is not recursive
+}
+
+// WithBuilder helps to build SQL queries using common table expressions, i.e. WITH ... SELECT.
+// Common table expression names could be used as table names in main query, joins or subqueries.
+// Use With or WithRecursive to start building and WithBuilder.Select to set main query.
+type WithBuilder struct {
+	tables []commonTable
+	query  SubQuery // main query
+}
+
+// With generates new WithBuilder having additional common table expression.
+// Takes expression name and query, i.e. BaseSelectBuilder.
+func (builder WithBuilder) With(name TableName, query SubQuery) WithBuilder {
+	return builder.add(commonTable{name: name, query: query})
+}
+
+// WithRecursive generates new WithBuilder having additional recursive common table expression.
+// Takes expression name, anchor member query and recursive member query referencing expression name.
+// Members are combined by UNION ALL.
+func (builder WithBuilder) WithRecursive(name TableName, anchor SubQuery, recursive SubQuery) WithBuilder {
+	return builder.add(commonTable{name: name, query: anchor, recursive: recursive})
+}
+
+// add returns a copy of WithBuilder having specified common table expression appended.
+func (builder WithBuilder) add(table commonTable) WithBuilder {
+	builder.tables = append(append(make([]commonTable, 0, len(builder.tables)+1), builder.tables...), table)
+	return builder
+}
+
+// Select generates new WithBuilder having main query set to specified one.
+// Main query could use common table expressions names as table names.
+func (builder WithBuilder) Select(query SubQuery) WithBuilder {
+	builder.query = query
+	return builder
+}
+
+// isRecursive returns true if any of common table expressions is recursive.
+func (builder WithBuilder) isRecursive() bool {
+	for _, table := range builder.tables {
+		if table.recursive != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RenderDialect renders SQL WITH clause followed by main query using specified Dialect placeholders.
+// Takes existed parameters count (0 means no parameters are defined yet),
+// every expression and main query parameters are numbered in order of appearance.
+// Implements DialectClauseRenderer.
+func (builder WithBuilder) RenderDialect(dialect Dialect, parametersCount int) (sql string) {
+	keyword := "WITH"
+	if builder.isRecursive() && dialect.kind != kindSQLServer && dialect.kind != kindOracle {
+		keyword += " RECURSIVE" // SQL Server and Oracle detect recursive expressions themselves
+	}
+
+	expressions := make([]string, len(builder.tables))
+
+	for idx, table := range builder.tables {
+		members := table.query.RenderDialect(dialect, parametersCount)
+		parametersCount += len(table.query.ValuesFor(dialect))
+
+		if table.recursive != nil {
+			members += " UNION ALL " + table.recursive.RenderDialect(dialect, parametersCount)
+			parametersCount += len(table.recursive.ValuesFor(dialect))
+		}
+
+		expressions[idx] = dialect.QuoteIdent(string(table.name)) + " " + kwAs.String() + " (" + members + ")"
+	}
+
+	sql = keyword + " " + strings.Join(expressions, ", ")
+	if builder.query != nil {
+		sql += " " + builder.query.RenderDialect(dialect, parametersCount)
+	}
+
+	return sql
+}
+
+// ValuesFor returns a set of parameters to substitute into query rendered with specified Dialect.
+// Implements SubQuery.
+func (builder WithBuilder) ValuesFor(dialect Dialect) (params []any) {
+	params = make([]any, 0)
+
+	for _, table := range builder.tables {
+		params = append(params, table.query.ValuesFor(dialect)...)
+		if table.recursive != nil {
+			params = append(params, table.recursive.ValuesFor(dialect)...)
+		}
+	}
+
+	if builder.query != nil {
+		params = append(params, builder.query.ValuesFor(dialect)...)
+	}
+
+	return params
+}
+
+// Values returns a set of parameters to substitute into rendered query.
+// Implements ValuesProvider.
+func (builder WithBuilder) Values() (params []any) {
+	return builder.ValuesFor(PostgreSQL)
+}
+
+// FieldDefinitions returns a list of fields main query selects.
+// Implements SubQuery.
+func (builder WithBuilder) FieldDefinitions() []FieldDefinition {
+	if builder.query == nil {
+		return make([]FieldDefinition, 0)
+	}
+
+	return builder.query.FieldDefinitions()
+}
+
+// String returns debug representation of WithBuilder query having parameters inlined, see DebugSQL.
+// Implements fmt.Stringer.
+func (builder WithBuilder) String() string {
+	return DebugSQL(builder)
+}
+
+// BuildQueryAndParams generates sql query string with desired parameters set.
+// If query generation failed returns empty query and parameters set or non-nil error.
+// Uses PostgreSQL "$N" placeholders, use BuildQueryAndParamsFor to generate query for another Dialect.
+func (builder WithBuilder) BuildQueryAndParams() (sql string, params []any, err error) {
+	return builder.BuildQueryAndParamsFor(PostgreSQL)
+}
+
+// BuildQueryAndParamsFor generates sql query string with desired parameters set using specified Dialect.
+// Returns error if main query is not set, any expression name is empty or used twice.
+func (builder WithBuilder) BuildQueryAndParamsFor(dialect Dialect) (sql string, params []any, err error) {
+	if builder.query == nil {
+		return "", make([]any, 0), fmt.Errorf("%w: no main query set for common table expressions", Error)
+	}
+
+	names := make(map[TableName]struct{}, len(builder.tables))
+
+	for _, table := range builder.tables {
+		if len(table.name) == 0 {
+			return "", make([]any, 0), fmt.Errorf("%w: common table expression name empty", Error)
+		}
+
+		if _, found := names[table.name]; found {
+			return "", make([]any, 0), fmt.Errorf("%w: common table expression %v defined twice", Error, table.name)
+		}
+
+		names[table.name] = struct{}{}
+	}
+
+	return builder.RenderDialect(dialect, 0), builder.ValuesFor(dialect), nil
+}
+
+// With makes a new WithBuilder instance having single common table expression.
+// Takes expression name and query, i.e. BaseSelectBuilder.
+// Use WithBuilder.Select to set main query using expression name as table name.
+func With(name TableName, query SubQuery) WithBuilder {
+	return WithBuilder{}.With(name, query)
+}
+
+// WithRecursive makes a new WithBuilder instance having single recursive common table expression.
+// Takes expression name, anchor member query and recursive member query referencing expression name.
+// Members are combined by UNION ALL. Use WithBuilder.Select to set main query.
+func WithRecursive(name TableName, anchor SubQuery, recursive SubQuery) WithBuilder {
+	return WithBuilder{}.WithRecursive(name, anchor, recursive)
+}
@@ -0,0 +1,104 @@
+package query_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/amarin/query"
+)
+
+func TestWithBuilder_BuildQueryAndParamsFor(t *testing.T) {
+	recent := query.SelectFrom("orders").
+		Fields(query.Field("customer_id"), query.Sum("total").As("amount")).
+		Where(query.GreaterOrEqual("created_at", "2024-01-01")).
+		GroupBy(query.Field("customer_id"))
+	categories := query.Table("categories").As("c")
+	tree := query.Table("tree").As("t")
+	treeQuery := query.WithRecursive("tree",
+		query.SelectFrom("categories").
+			Fields(query.Field("id"), query.Field("parent_id")).
+			Where(query.EqualTo("id", 1)),
+		query.SelectFrom(categories).
+			Fields(categories.Field("id"), categories.Field("parent_id")).
+			InnerJoin(tree).On(categories.Field("parent_id"), tree.Field("id")).
+			Where(query.EqualTo("c.active", true)),
+	)
+
+	tests := []struct {
+		name       string
+		builder    query.WithBuilder
+		dialect    query.Dialect
+		wantSQL    string
+		wantParams []any
+		wantErr    bool
+	}{
+		{
+			"single",
+			query.With("recent", recent).Select(
+				query.SelectFrom("recent").Where(query.GreaterThan("amount", 100)).OrderBy(query.DESC("amount")).Limit(10),
+			),
+			query.PostgreSQL,
+			"WITH recent AS (SELECT customer_id, SUM(total) AS amount FROM orders WHERE created_at>=$1 " +
+				"GROUP BY customer_id) SELECT * FROM recent WHERE amount>$2 ORDER BY amount DESC LIMIT $3",
+			[]any{"2024-01-01", 100, uint(10)},
+			false,
+		},
+		{
+			"several_with_join_and_in",
+			query.With("recent", recent).
+				With("vip", query.SelectFrom("customers").Fields(query.Field("id")).Where(query.EqualTo("vip", true))).
+				Select(query.SelectFrom("recent").Where(query.In("customer_id", query.SelectFrom("vip")))),
+			query.MySQL,
+			"WITH recent AS (SELECT customer_id, SUM(total) AS amount FROM orders WHERE created_at>=? " +
+				"GROUP BY customer_id), vip AS (SELECT id FROM customers WHERE vip=?) " +
+				"SELECT * FROM recent WHERE customer_id IN (SELECT * FROM vip)",
+			[]any{"2024-01-01", true},
+			false,
+		},
+		{
+			"recursive",
+			treeQuery.Select(query.SelectFrom("tree").Where(query.GreaterThan("id", 5).Or(query.IsNull("parent_id")))),
+			query.PostgreSQL,
+			"WITH RECURSIVE tree AS (SELECT id, parent_id FROM categories WHERE id=$1 " +
+				"UNION ALL SELECT c.id, c.parent_id FROM categories AS c INNER JOIN tree AS t ON c.parent_id=t.id " +
+				"WHERE c.active=$2) SELECT * FROM tree WHERE id>$3 OR parent_id IS NULL",
+			[]any{1, true, 5},
+			false,
+		},
+		{
+			"recursive_sql_server",
+			treeQuery.Select(query.SelectFrom("tree")),
+			query.SQLServer,
+			"WITH tree AS (SELECT id, parent_id FROM categories WHERE id=@p1 " +
+				"UNION ALL SELECT c.id, c.parent_id FROM categories AS c INNER JOIN tree AS t ON c.parent_id=t.id " +
+				"WHERE c.active=@p2) SELECT * FROM tree",
+			[]any{1, true},
+			false,
+		},
+		{
+			"no_main_query",
+			query.With("recent", recent),
+			query.PostgreSQL,
+			"", nil, true,
+		},
+		{
+			"duplicated_name",
+			query.With("recent", recent).With("recent", recent).Select(query.SelectFrom("recent")),
+			query.PostgreSQL,
+			"", nil, true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSQL, gotParams, err := tt.builder.BuildQueryAndParamsFor(tt.dialect)
+			require.Equalf(t, tt.wantErr, err != nil, "want error %v, got %v", tt.wantErr, err)
+			if err != nil {
+				require.ErrorIs(t, err, query.Error)
+				return
+			}
+			require.Equal(t, tt.wantSQL, gotSQL)
+			require.Equal(t, tt.wantParams, gotParams)
+		})
+	}
+}